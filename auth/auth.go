@@ -2,9 +2,13 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"github.com/autom8ter/geodb/config"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/labstack/echo"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"net/http"
 
 	"google.golang.org/grpc/status"
 )
@@ -16,10 +20,62 @@ func BasicAuthFunc() grpc_auth.AuthFunc {
 			if err != nil {
 				return nil, status.Errorf(codes.Unauthenticated, "failed to find authentication header with basic scheme\n%v", err)
 			}
-			if basicAuth != config.Config.GetString("GEODB_PASSWORD") {
+			if !equal(basicAuth, config.Config.GetString("GEODB_PASSWORD")) {
 				return nil, status.Error(codes.Unauthenticated, "invalid password")
 			}
 		}
 		return ctx, nil
 	}
 }
+
+// BasicAuthMiddleware applies the same basic authentication as BasicAuthFunc to http requests
+func BasicAuthMiddleware() echo.MiddlewareFunc {
+	authFunc := BasicAuthFunc()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := authenticate(c, authFunc); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// BrowserAuthMiddleware applies the same basic authentication as BasicAuthMiddleware but also accepts credentials browsers can send without setting headers(ex: EventSource)-
+// standard http basic authentication with the GEODB_PASSWORD as the password(any username) or the GEODB_SSE_TOKEN as the token query parameter.
+// The password is never accepted in the query- urls end up in access logs & browser history, so the token should only grant access to the routes using this middleware.
+// Unauthenticated requests are asked for basic authentication credentials.
+func BrowserAuthMiddleware() echo.MiddlewareFunc {
+	authFunc := BasicAuthFunc()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Config.IsSet("GEODB_PASSWORD") {
+				if _, pass, ok := c.Request().BasicAuth(); ok && equal(pass, config.Config.GetString("GEODB_PASSWORD")) {
+					return next(c)
+				}
+				if token := c.QueryParam("token"); token != "" && config.Config.IsSet("GEODB_SSE_TOKEN") && equal(token, config.Config.GetString("GEODB_SSE_TOKEN")) {
+					return next(c)
+				}
+			}
+			if err := authenticate(c, authFunc); err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="geodb"`)
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// authenticate applies authFunc to the authorization header of the request
+func authenticate(c echo.Context, authFunc grpc_auth.AuthFunc) error {
+	ctx := metadata.NewIncomingContext(c.Request().Context(), metadata.Pairs("authorization", c.Request().Header.Get("Authorization")))
+	if _, err := authFunc(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, status.Convert(err).Message())
+	}
+	return nil
+}
+
+// equal compares the credentials in constant time so that they can't be guessed from response times
+func equal(given, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(expected)) == 1
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	geodb "github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/server"
+	"github.com/dgraph-io/badger/v2"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// csvChunkSize is the number of bytes of a csv file that import-csv sends per message
+const csvChunkSize = 64 * 1024
+
+// commands are the subcommands of the geodb binary. geodb runs the server if it's called without a subcommand.
+var commands = map[string]func(args []string) error{
+	"import-csv":   importCSV,
+	"import-trace": importTrace,
+	"backup":       backup,
+	"restore":      restore,
+}
+
+// dial connects to the geodb server at address & returns a context that carries the GEODB_PASSWORD(if set) as basic authentication
+func dial(address string) (*grpc.ClientConn, context.Context, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := context.Background()
+	if config.Config.IsSet("GEODB_PASSWORD") {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "basic "+config.Config.GetString("GEODB_PASSWORD"))
+	}
+	return conn, ctx, nil
+}
+
+// importCSV streams a csv file into a running geodb server & logs every row that failed to be imported
+func importCSV(args []string) error {
+	flags := flag.NewFlagSet("import-csv", flag.ContinueOnError)
+	address := flags.String("address", "localhost"+config.Config.GetString("GEODB_PORT"), "the address of the geodb server")
+	keyColumn := flags.String("key-column", "", "the column that holds the object key(default id)")
+	latColumn := flags.String("lat-column", "", "the column that holds the latitude(default lat)")
+	lonColumn := flags.String("lon-column", "", "the column that holds the longitude(default lon)")
+	radiusColumn := flags.String("radius-column", "", "the column that holds the object radius in meters(default radius)")
+	expiresColumn := flags.String("expires-column", "", "the column that holds the objects expires_unix(default expires_unix)")
+	defaultRadius := flags.Int64("default-radius", 1, "the radius in meters of rows without a radius")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: geodb import-csv [flags] <file.csv>")
+	}
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	conn, ctx, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := api.NewGeoDBClient(conn).ImportCSV(ctx)
+	if err != nil {
+		return err
+	}
+	msg := &api.ImportCSVRequest{
+		KeyColumn:     *keyColumn,
+		LatColumn:     *latColumn,
+		LonColumn:     *lonColumn,
+		RadiusColumn:  *radiusColumn,
+		ExpiresColumn: *expiresColumn,
+		DefaultRadius: *defaultRadius,
+	}
+	buf := make([]byte, csvChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			msg.Chunk = buf[:n]
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+			msg = &api.ImportCSVRequest{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	for _, rowErr := range resp.Errors {
+		log.Warnf("row %v(%s) failed to be imported: %s", rowErr.Row, rowErr.Key, rowErr.Error)
+	}
+	if truncated := resp.Failed - int64(len(resp.Errors)); truncated > 0 {
+		log.Warnf("the errors of %v more rows were truncated", truncated)
+	}
+	log.Infof("imported %v rows, %v rows failed", resp.Imported, resp.Failed)
+	return nil
+}
+
+// importTrace sets the points of a GPX or KML file as time-ordered updates of an object in a running geodb server & logs every update
+func importTrace(args []string) error {
+	flags := flag.NewFlagSet("import-trace", flag.ContinueOnError)
+	address := flags.String("address", "localhost"+config.Config.GetString("GEODB_PORT"), "the address of the geodb server")
+	key := flags.String("key", "", "the key of the object that follows the trace")
+	format := flags.String("format", "", "the format of the trace(gpx or kml). defaults to the file extension")
+	radius := flags.Int64("radius", 1, "the radius of the object in meters")
+	speed := flags.Float64("speed", 0, "0 imports the points with their recorded timestamps. otherwise the trace is replayed in real time(1) or accelerated(>1)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 || *key == "" {
+		return errors.New("usage: geodb import-trace -key <key> [flags] <file.gpx|file.kml>")
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(flags.Arg(0)), ".")
+	}
+	traceFormat, ok := api.TraceFormat_value[strings.ToUpper(*format)]
+	if !ok {
+		return fmt.Errorf("unsupported trace format: %s", *format)
+	}
+	trace, err := ioutil.ReadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	conn, ctx, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := api.NewGeoDBClient(conn).ImportTrace(ctx, &api.ImportTraceRequest{
+		Key:    *key,
+		Format: api.TraceFormat(traceFormat),
+		Trace:  string(trace),
+		Radius: *radius,
+		Speed:  *speed,
+	})
+	if err != nil {
+		return err
+	}
+	count := 0
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		count++
+		log.Infof("set %s to %v,%v", resp.Object.Object.Key, resp.Object.Object.Point.Lat, resp.Object.Object.Point.Lon)
+	}
+	log.Infof("imported %v points", count)
+	return nil
+}
+
+// backup writes a full or incremental backup of a running geodb server to a local file. The file is only created once the backup is complete.
+func backup(args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	address := flags.String("address", "localhost"+config.Config.GetString("GEODB_PORT"), "the address of the geodb server")
+	since := flags.Uint64("since", 0, "take an incremental backup of the entries that changed since a previous backup(the since it logged). defaults to a full backup")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: geodb backup [flags] <file>")
+	}
+	conn, ctx, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := api.NewGeoDBClient(conn).Backup(ctx, &api.BackupRequest{
+		Since: *since,
+	})
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(flags.Arg(0)), filepath.Base(flags.Arg(0))+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	var next uint64
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(resp.Chunk); err != nil {
+			return err
+		}
+		if resp.Since > 0 {
+			next = resp.Since
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), flags.Arg(0)); err != nil {
+		return err
+	}
+	log.Infof("backed up to %s- pass -since %v to take an incremental backup", flags.Arg(0), next)
+	return nil
+}
+
+// restore loads full & incremental backups(in the order they were taken) into a fresh data directory that geodb can be started with as its GEODB_PATH
+func restore(args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	path := flags.String("path", "", "the data directory to restore into. it must not exist or be empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 || *path == "" {
+		return errors.New("usage: geodb restore -path <data directory> <full backup> [incremental backups...]")
+	}
+	entries, err := ioutil.ReadDir(*path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%s is not empty- backups must be restored into a fresh data directory", *path)
+	}
+	db, err := server.OpenDB(*path)
+	if err != nil {
+		return err
+	}
+	for _, name := range flags.Args() {
+		if err := restoreFile(db, name); err != nil {
+			db.Close()
+			return err
+		}
+		log.Infof("restored %s", name)
+	}
+	return db.Close()
+}
+
+func restoreFile(db *badger.DB, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return geodb.Restore(db, f)
+}
@@ -9,7 +9,13 @@ func init() {
 	Config.SetDefault("GEODB_PORT", ":8080")
 	Config.SetDefault("GEODB_PATH", "/tmp/geodb")
 	Config.SetDefault("GEODB_GC_INTERVAL", "5m")
+	Config.SetDefault("GEODB_EXPIRY_INTERVAL", "1s")
 	Config.SetDefault("GEODB_GMAPS_CACHE_DURATION", "1h")
+	Config.SetDefault("GEODB_GEOFENCE_EVENT_RETENTION", "168h")
+	Config.SetDefault("GEODB_HISTORY_RETENTION", "168h")
+	Config.SetDefault("GEODB_CHANGELOG_RETENTION", "24h")
+	Config.SetDefault("GEODB_STREAM_BUFFER_SIZE", 1000)
+	Config.SetDefault("GEODB_STREAM_OVERFLOW_POLICY", "drop_oldest")
 	Config.AutomaticEnv()
 }
 
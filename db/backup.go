@@ -0,0 +1,34 @@
+package db
+
+import (
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+// restorePendingWrites is the max number of pending writes while a backup is loaded
+const restorePendingWrites = 256
+
+// Backup writes a consistent backup of every entry that changed since the given version(0 for a full backup) to w while the database keeps serving traffic.
+// It returns the version to pass as since to take an incremental backup of the entries that change after this backup.
+func Backup(db *badger.DB, w io.Writer, since uint64) (uint64, error) {
+	version, err := db.Backup(w, since)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to backup database: %s", err.Error())
+	}
+	//version is the last version that was backed up- or 0 if nothing changed since the previous backup
+	if version < since {
+		return since, nil
+	}
+	return version + 1, nil
+}
+
+// Restore loads a full or incremental backup into the database. Incremental backups must be loaded after the backups they were taken since.
+// The database must not serve traffic while a backup is loaded- backups are meant to be restored into a fresh data directory.
+func Restore(db *badger.DB, r io.Reader) error {
+	if err := db.Load(r, restorePendingWrites); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore backup: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"sync"
+)
+
+const (
+	// setBatchSize is the max number of objects written in a single transaction
+	setBatchSize = 100
+	// enrichConcurrency is the max number of objects of a batch that are enriched concurrently
+	enrichConcurrency = 16
+)
+
+type pendingObject struct {
+	index         int
+	detail        *api.ObjectDetail
+	trackerEvents map[string]*api.TrackerEvent
+}
+
+// SetBatch sets many objects at once. Objects are validated & enriched concurrently and written in transactions of up to setBatchSize objects.
+// A result is returned per object in the same order as the objects- invalid objects or objects that failed to be written are reported in the results error without aborting the batch.
+func SetBatch(db *badger.DB, maps *maps.Client, hub *stream.Hub, objects []*api.Object) []*api.SetResult {
+	results := make([]*api.SetResult, len(objects))
+	pending := make([]*pendingObject, len(objects))
+	wg := &sync.WaitGroup{}
+	sem := make(chan struct{}, enrichConcurrency)
+	for i, obj := range objects {
+		results[i] = &api.SetResult{
+			Key: obj.GetKey(),
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, obj *api.Object) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			detail, trackerEvents, err := enrichObject(db, maps, obj)
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			pending[i] = &pendingObject{
+				index:         i,
+				detail:        detail,
+				trackerEvents: trackerEvents,
+			}
+		}(i, obj)
+	}
+	wg.Wait()
+	var batch []*pendingObject
+	for _, p := range pending {
+		if p == nil {
+			continue
+		}
+		batch = append(batch, p)
+		if len(batch) == setBatchSize {
+			setPendingObjects(db, maps, hub, batch, results)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		setPendingObjects(db, maps, hub, batch, results)
+	}
+	return results
+}
+
+// setPendingObjects writes the objects in a single transaction. If the transaction fails, every object is retried in its own transaction so that errors are reported per object.
+// Single object transactions are retried while they conflict with concurrent writes of the same key.
+func setPendingObjects(db *badger.DB, maps *maps.Client, hub *stream.Hub, batch []*pendingObject, results []*api.SetResult) {
+	type written struct {
+		event          *api.ObjectEvent
+		geofenceEvents []*api.GeofenceEvent
+	}
+	var writes []written
+	write := func() error {
+		writes = nil
+		txn := db.NewTransaction(true)
+		defer txn.Discard()
+		var events []*api.ObjectEvent
+		for _, p := range batch {
+			// tracker events are appended to the detail by setObject
+			p.detail.TrackerEvents = nil
+			event, geofenceEvents, err := setObject(db, txn, p.detail, p.trackerEvents)
+			if err != nil {
+				return err
+			}
+			writes = append(writes, written{event: event, geofenceEvents: geofenceEvents})
+			events = append(events, event)
+		}
+		return commitChangeLog(db, txn.SetEntry, func() error {
+			return commit(txn)
+		}, events...)
+	}
+	if len(batch) == 1 {
+		if err := retryConflicts(write); err != nil {
+			results[batch[0].index].Error = err.Error()
+			return
+		}
+	} else if err := write(); err != nil {
+		for _, p := range batch {
+			setPendingObjects(db, maps, hub, []*pendingObject{p}, results)
+		}
+		return
+	}
+	for i, w := range writes {
+		results[batch[i].index].Object = w.event.Object
+		publishObject(db, maps, hub, w.event, w.geofenceEvents)
+	}
+}
@@ -0,0 +1,187 @@
+package db
+
+import (
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	changeLogPrefix      = "geodb_changelog_"
+	changeLogSequenceKey = "geodb_sequence_changelog"
+	// changeLogBandwidth is the number of sequence numbers leased from badger at once
+	changeLogBandwidth = 1000
+)
+
+// changeLog is the sequence & reorder buffer of a databases change log
+type changeLog struct {
+	sequence *badger.Sequence
+	mu       *sync.Mutex
+	// committed is signalled whenever sequence numbers are removed from pending
+	committed *sync.Cond
+	// pending holds the sequence numbers that were assigned but whose commit hasn't finished yet
+	pending map[uint64]struct{}
+}
+
+var (
+	changeLogMu = &sync.Mutex{}
+	changeLogs  = map[*badger.DB]*changeLog{}
+)
+
+func changeLogKey(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", changeLogPrefix, sequence))
+}
+
+// getChangeLog returns the change log of the database
+func getChangeLog(db *badger.DB) (*changeLog, error) {
+	changeLogMu.Lock()
+	defer changeLogMu.Unlock()
+	log, ok := changeLogs[db]
+	if !ok {
+		seq, err := db.GetSequence([]byte(changeLogSequenceKey), changeLogBandwidth)
+		if err != nil {
+			return nil, err
+		}
+		mu := &sync.Mutex{}
+		log = &changeLog{
+			sequence:  seq,
+			mu:        mu,
+			committed: sync.NewCond(mu),
+			pending:   map[uint64]struct{}{},
+		}
+		changeLogs[db] = log
+	}
+	return log, nil
+}
+
+// commitChangeLog assigns the events the next sequence numbers, writes them to the change log with set(a transaction or write batch) & calls commit.
+// Only the sequence numbers are assigned under the change logs lock- transactions commit concurrently, but commitChangeLog doesn't return until
+// every earlier sequence number has finished committing, so callers publish their events after all of the earlier events were committed.
+// Streams skip live events up to the last sequence they replayed, so an event that was published before an earlier one was committed could be lost.
+func commitChangeLog(db *badger.DB, set func(e *badger.Entry) error, commit func() error, events ...*api.ObjectEvent) error {
+	log, err := getChangeLog(db)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record change: %s", err.Error())
+	}
+	if len(events) == 0 {
+		return commit()
+	}
+	log.mu.Lock()
+	var sequences []uint64
+	for _, event := range events {
+		next, err := log.sequence.Next()
+		if err != nil {
+			log.release(sequences)
+			log.mu.Unlock()
+			return status.Errorf(codes.Internal, "failed to record change: %s", err.Error())
+		}
+		// sequence numbers start at 1
+		event.Sequence = next + 1
+		log.pending[event.Sequence] = struct{}{}
+		sequences = append(sequences, event.Sequence)
+	}
+	log.mu.Unlock()
+	for _, event := range events {
+		if err := setChangeLog(set, event); err != nil {
+			log.mu.Lock()
+			log.release(sequences)
+			log.mu.Unlock()
+			return status.Errorf(codes.Internal, "failed to record change: %s", err.Error())
+		}
+	}
+	err = commit()
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	// sequence numbers of failed commits are released too- they are left as gaps in the change log
+	log.release(sequences)
+	for log.firstPending() < sequences[0] {
+		log.committed.Wait()
+	}
+	return err
+}
+
+// release removes the sequence numbers from pending & wakes the commits that are waiting on them. The change logs lock must be held.
+func (c *changeLog) release(sequences []uint64) {
+	for _, sequence := range sequences {
+		delete(c.pending, sequence)
+	}
+	c.committed.Broadcast()
+}
+
+// firstPending returns the lowest sequence number whose commit hasn't finished, or math.MaxUint64 if there is none. The change logs lock must be held.
+func (c *changeLog) firstPending() uint64 {
+	var first uint64 = math.MaxUint64
+	for sequence := range c.pending {
+		if sequence < first {
+			first = sequence
+		}
+	}
+	return first
+}
+
+// setChangeLog writes the event to the change log with set
+func setChangeLog(set func(e *badger.Entry) error, event *api.ObjectEvent) error {
+	bits, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var expires uint64
+	if retention := config.Config.GetDuration("GEODB_CHANGELOG_RETENTION"); retention > 0 {
+		expires = uint64(time.Now().Add(retention).Unix())
+	}
+	return set(&badger.Entry{
+		Key:       changeLogKey(event.Sequence),
+		Value:     bits,
+		UserMeta:  changeLogMeta,
+		ExpiresAt: expires,
+	})
+}
+
+// ReplayChangeLog calls fn with every change log event that has a sequence greater than after & occurred at or after since(unix), in sequence order
+func ReplayChangeLog(db *badger.DB, after uint64, since int64, fn func(event *api.ObjectEvent) error) error {
+	log, err := getChangeLog(db)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get change log: %s", err.Error())
+	}
+	// events from the first pending sequence on are skipped so that a replay never returns a later sequence without the earlier ones-
+	// they are published once every earlier sequence has been committed
+	log.mu.Lock()
+	first := log.firstPending()
+	txn := db.NewTransaction(false)
+	log.mu.Unlock()
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(changeLogPrefix)
+	for iter.Seek(changeLogKey(after + 1)); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != changeLogMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var event = &api.ObjectEvent{}
+		if err := proto.Unmarshal(res, event); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if event.Sequence >= first {
+			break
+		}
+		if event.TimestampUnix < since {
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
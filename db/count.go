@@ -0,0 +1,89 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"strings"
+)
+
+// Count aggregates the objects that match every given selector(see selectObjects) without returning them.
+// If groupBy is not empty, the objects are additionally aggregated per value of the groupBy metadata field.
+func Count(db *badger.DB, bound *api.Bound, polygon *api.Polygon, keys []string, prefix, rgex string, filter *api.MetadataFilter, groupBy string) (*api.Aggregate, map[string]*api.Aggregate, error) {
+	total := &api.Aggregate{}
+	var groups map[string]*api.Aggregate
+	if groupBy != "" {
+		groups = map[string]*api.Aggregate{}
+	}
+	if err := selectObjects(db, bound, polygon, keys, prefix, rgex, filter, func(key string, obj *api.ObjectDetail, cursor string) error {
+		aggregate(total, obj.Object)
+		if groups != nil {
+			value := obj.Object.Metadata[groupBy]
+			if groups[value] == nil {
+				groups[value] = &api.Aggregate{}
+			}
+			aggregate(groups[value], obj.Object)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return total, groups, nil
+}
+
+// selectObjects calls fn with every object that matches every given selector. The bound or polygon is served from the geohash index,
+// otherwise keys, prefix or regex select the objects to visit.
+func selectObjects(db *badger.DB, bound *api.Bound, polygon *api.Polygon, keys []string, prefix, rgex string, filter *api.MetadataFilter, fn ObjectFunc) error {
+	if bound != nil && polygon != nil {
+		return status.Error(codes.InvalidArgument, "only one of bound or polygon may be set")
+	}
+	if bound != nil && bound.Center == nil {
+		return status.Error(codes.InvalidArgument, "bound center is required")
+	}
+	var reg *regexp.Regexp
+	if rgex != "" {
+		r, err := regexp.Compile(rgex)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+		}
+		reg = r
+	}
+	match := func(key string, obj *api.ObjectDetail, cursor string) error {
+		if prefix != "" && !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		if reg != nil && !reg.MatchString(key) {
+			return nil
+		}
+		return fn(key, obj, cursor)
+	}
+	var err error
+	switch {
+	case bound != nil:
+		_, err = ScanBoundFunc(db, bound, keys, filter, Page{}, match)
+	case polygon != nil:
+		_, err = ScanPolygonFunc(db, polygon, keys, filter, Page{}, match)
+	case len(keys) > 0:
+		_, err = GetFunc(db, keys, filter, Page{}, match)
+	case prefix != "":
+		_, err = GetPrefixFunc(db, prefix, filter, Page{}, match)
+	case rgex != "":
+		_, err = GetRegexFunc(db, rgex, filter, Page{}, match)
+	default:
+		_, err = GetFunc(db, nil, filter, Page{}, match)
+	}
+	return err
+}
+
+// aggregate adds the object to the aggregate
+func aggregate(agg *api.Aggregate, obj *api.Object) {
+	if agg.Count == 0 || obj.UpdatedUnix < agg.MinUpdatedUnix {
+		agg.MinUpdatedUnix = obj.UpdatedUnix
+	}
+	if agg.Count == 0 || obj.UpdatedUnix > agg.MaxUpdatedUnix {
+		agg.MaxUpdatedUnix = obj.UpdatedUnix
+	}
+	agg.Count++
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"encoding/csv"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"sort"
+)
+
+const (
+	// csvBatchSize is the number of csv rows that are buffered before they are written as a batch
+	csvBatchSize = 500
+	// csvMaxErrors is the max number of row errors returned by ImportCSV
+	csvMaxErrors = 1000
+)
+
+// ImportCSV sets an object per row of the csv using the mapping. The first row must be a header. Rows are validated like any other object & rows that fail to be
+// imported are reported as row errors without aborting the import. It returns the number of imported & failed rows along with the errors of the first
+// csvMaxErrors failed rows. It returns an error only if the csv can't be read at all.
+func ImportCSV(db *badger.DB, maps *maps.Client, hub *stream.Hub, r io.Reader, mapping helpers.CSVMapping) (int64, int64, []*api.CSVRowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return 0, 0, nil, status.Error(codes.InvalidArgument, "csv is empty")
+		}
+		return 0, 0, nil, status.Errorf(codes.InvalidArgument, "failed to read csv header: %s", err.Error())
+	}
+	columns, err := helpers.NewCSVColumns(header, mapping)
+	if err != nil {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var (
+		imported  int64
+		failed    int64
+		rowErrors []*api.CSVRowError
+		objects   []*api.Object
+		rows      []int64
+	)
+	// the errors of a batch are added after the parse errors of later rows, so the errors are kept sorted & truncated to the first rows as they grow
+	truncate := func() {
+		sort.Slice(rowErrors, func(i, j int) bool {
+			return rowErrors[i].Row < rowErrors[j].Row
+		})
+		if len(rowErrors) > csvMaxErrors {
+			rowErrors = rowErrors[:csvMaxErrors]
+		}
+	}
+	addError := func(rowErr *api.CSVRowError) {
+		failed++
+		rowErrors = append(rowErrors, rowErr)
+		if len(rowErrors) >= 2*csvMaxErrors {
+			truncate()
+		}
+	}
+	flush := func() {
+		for i, result := range SetBatch(db, maps, hub, objects) {
+			if result.Error != "" {
+				addError(&api.CSVRowError{
+					Row:   rows[i],
+					Key:   result.Key,
+					Error: result.Error,
+				})
+				continue
+			}
+			imported++
+		}
+		objects, rows = nil, nil
+	}
+	//the header is row 1
+	row := int64(1)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); !ok {
+				truncate()
+				return imported, failed, rowErrors, status.Errorf(codes.Internal, "failed to read csv: %s", err.Error())
+			}
+			addError(&api.CSVRowError{
+				Row:   row,
+				Error: err.Error(),
+			})
+			continue
+		}
+		obj, err := columns.Object(record)
+		if err != nil {
+			addError(&api.CSVRowError{
+				Row:   row,
+				Key:   columns.Key(record),
+				Error: err.Error(),
+			})
+			continue
+		}
+		objects = append(objects, obj)
+		rows = append(rows, row)
+		if len(objects) == csvBatchSize {
+			flush()
+		}
+	}
+	if len(objects) > 0 {
+		flush()
+	}
+	truncate()
+	return imported, failed, rowErrors, nil
+}
@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const expiryPrefix = "geodb_expiry_"
+
+// expiryKey orders expiry index entries by the time the object expires
+func expiryKey(expires int64, key string) []byte {
+	return []byte(fmt.Sprintf("%s%020d_%s", expiryPrefix, expires, key))
+}
+
+// setExpiryIndex keeps a copy of objects that expire so that an Expired event can be published once badger has removed the object.
+// The entry of the previous version of the object is removed.
+func setExpiryIndex(txn *badger.Txn, detail *api.ObjectDetail, bits []byte, previous *api.ObjectDetail) error {
+	if err := deleteExpiryIndex(txn, previous); err != nil {
+		return err
+	}
+	if detail.Object.ExpiresUnix <= 0 {
+		return nil
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:      expiryKey(detail.Object.ExpiresUnix, detail.Object.Key),
+		Value:    bits,
+		UserMeta: expiryMeta,
+	})
+}
+
+// deleteExpiryIndex removes the expiry index entry of the object(if it has one)
+func deleteExpiryIndex(txn *badger.Txn, detail *api.ObjectDetail) error {
+	if detail == nil || detail.Object == nil || detail.Object.ExpiresUnix <= 0 {
+		return nil
+	}
+	return txn.Delete(expiryKey(detail.Object.ExpiresUnix, detail.Object.Key))
+}
+
+// ExpireObjects publishes an Expired event for every object that has expired since the last call, an Exit event for every geofence it was inside of
+// & removes it from the trackers that target it
+func ExpireObjects(db *badger.DB, hub *stream.Hub) error {
+	now := time.Now().Unix()
+	var (
+		entries [][]byte
+		expired []*api.ObjectDetail
+		fences  []*api.Geofence
+	)
+	if err := db.View(func(txn *badger.Txn) error {
+		var err error
+		if fences, err = listGeofences(txn); err != nil {
+			return err
+		}
+		iter := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iter.Close()
+		prefix := []byte(expiryPrefix)
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != expiryMeta {
+				continue
+			}
+			var expires int64
+			if _, err := fmt.Sscanf(string(item.Key()[len(prefix):]), "%020d", &expires); err != nil {
+				continue
+			}
+			if expires > now {
+				break
+			}
+			entries = append(entries, item.KeyCopy(nil))
+			res, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var detail = &api.ObjectDetail{}
+			if err := proto.Unmarshal(res, detail); err != nil {
+				return err
+			}
+			// the object may have been written again after it expired
+			current, err := getObject(txn, detail.Object.Key)
+			if err != nil {
+				return err
+			}
+			if current == nil {
+				expired = append(expired, detail)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range entries {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	var (
+		events         []*api.ObjectEvent
+		geofenceEvents []*api.GeofenceEvent
+	)
+	for _, detail := range expired {
+		event := &api.ObjectEvent{
+			Type:          api.EventType_Expired,
+			Object:        detail,
+			TimestampUnix: detail.Object.ExpiresUnix,
+		}
+		events = append(events, event)
+		exits := geofenceTransitions(fences, nil, detail, detail.Object.ExpiresUnix)
+		if err := setGeofenceEvents(wb.SetEntry, exits); err != nil {
+			return err
+		}
+		geofenceEvents = append(geofenceEvents, exits...)
+	}
+	if err := commitChangeLog(db, wb.SetEntry, wb.Flush, events...); err != nil {
+		return err
+	}
+	for _, event := range events {
+		hub.PublishObjectEvent(event)
+		updateTrackers(db, nil, hub, event.Object.Object.Key, nil)
+	}
+	for _, event := range geofenceEvents {
+		hub.PublishGeofenceEvent(event)
+	}
+	return nil
+}
+
+// WatchExpirations calls ExpireObjects every interval until the context is cancelled
+func WatchExpirations(ctx context.Context, db *badger.DB, hub *stream.Hub, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ExpireObjects(db, hub); err != nil {
+				log.Error(err.Error())
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package db
+
+import (
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"time"
+)
+
+const (
+	geofencePrefix      = "geodb_geofence_"
+	geofenceEventPrefix = "geodb_geoevent_"
+)
+
+func geofenceKey(name string) []byte {
+	return []byte(geofencePrefix + name)
+}
+
+// geofenceEventKey orders events by their timestamp. the nanosecond suffix keeps events that happen within the same second unique
+func geofenceEventKey(event *api.GeofenceEvent) []byte {
+	return []byte(fmt.Sprintf("%s%020d_%020d_%s_%s", geofenceEventPrefix, event.TimestampUnix, time.Now().UnixNano(), event.Geofence, event.Object.Key))
+}
+
+// CreateGeofence stores the geofence & publishes an Enter event for every object that is already inside of it. If it replaces a geofence with the same name,
+// the objects that were inside of the previous geofence only get an Exit event instead.
+func CreateGeofence(db *badger.DB, hub *stream.Hub, fence *api.Geofence) (*api.Geofence, error) {
+	if err := fence.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if (fence.Bound == nil) == (fence.Polygon == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of bound or polygon must be set")
+	}
+	if fence.Bound != nil && fence.Bound.Center == nil {
+		return nil, status.Error(codes.InvalidArgument, "bound center must be set")
+	}
+	if err := helpers.ValidatePolygon(fence.Polygon); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	bits, err := proto.Marshal(fence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal protobuf: %s", err.Error())
+	}
+	var events []*api.GeofenceEvent
+	if err := retryConflicts(func() error {
+		txn := db.NewTransaction(true)
+		defer txn.Discard()
+		previous, err := getGeofence(txn, fence.Name)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get geofence: %s", err.Error())
+		}
+		if err := txn.SetEntry(&badger.Entry{
+			Key:      geofenceKey(fence.Name),
+			Value:    bits,
+			UserMeta: geofenceMeta,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to set geofence: %s", err.Error())
+		}
+		events, err = geofenceMembership(txn, previous, fence, time.Now().Unix())
+		if err != nil {
+			return err
+		}
+		if err := setGeofenceEvents(txn.SetEntry, events); err != nil {
+			return status.Errorf(codes.Internal, "failed to set geofence events: %s", err.Error())
+		}
+		return commit(txn)
+	}); err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		hub.PublishGeofenceEvent(event)
+	}
+	return fence, nil
+}
+
+// getGeofence returns the geofence stored under name or nil if it doesn't exist
+func getGeofence(txn *badger.Txn, name string) (*api.Geofence, error) {
+	item, err := txn.Get(geofenceKey(name))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if item.UserMeta() != geofenceMeta {
+		return nil, nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	var fence = &api.Geofence{}
+	if err := proto.Unmarshal(res, fence); err != nil {
+		return nil, err
+	}
+	return fence, nil
+}
+
+// geofenceMembership returns an Enter event for every object that is inside of the fence but wasn't inside of its previous version(nil if the fence is new)
+// & an Exit event for every object that was only inside of the previous version
+func geofenceMembership(txn *badger.Txn, previous, fence *api.Geofence, timestamp int64) ([]*api.GeofenceEvent, error) {
+	var (
+		events []*api.GeofenceEvent
+		seen   = map[string]struct{}{}
+	)
+	// an object that is inside of both versions is visited by both scans
+	match := func(key string, obj *api.ObjectDetail) bool {
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	}
+	visit := func(key string, obj *api.ObjectDetail) error {
+		wasInside := previous != nil && helpers.GeofenceContains(previous, obj.Object)
+		inside := helpers.GeofenceContains(fence, obj.Object)
+		if wasInside == inside {
+			return nil
+		}
+		event := &api.GeofenceEvent{
+			Geofence:      fence.Name,
+			Object:        obj.Object,
+			Transition:    api.Transition_Enter,
+			TimestampUnix: timestamp,
+		}
+		if wasInside {
+			event.Transition = api.Transition_Exit
+		}
+		events = append(events, event)
+		return nil
+	}
+	fences := []*api.Geofence{fence}
+	if previous != nil {
+		fences = append(fences, previous)
+	}
+	for _, f := range fences {
+		if err := scanGeohashIndex(txn, geofenceCandidates(txn, f), nil, match, visit); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// geofenceCandidates returns the area that has to be scanned in the geohash index to find every object that may be inside of the geofence
+func geofenceCandidates(txn *badger.Txn, fence *api.Geofence) *geo.Bound {
+	if fence.Bound != nil {
+		return boundCandidates(txn, fence.Bound)
+	}
+	return helpers.PolygonBound(fence.Polygon)
+}
+
+func DeleteGeofence(db *badger.DB, names []string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	for _, name := range names {
+		if err := txn.Delete(geofenceKey(name)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete geofence: %s %s", name, err.Error())
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete geofences %s", err.Error())
+	}
+	return nil
+}
+
+func ListGeofences(db *badger.DB) ([]*api.Geofence, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	fences, err := listGeofences(txn)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list geofences: %s", err.Error())
+	}
+	return fences, nil
+}
+
+func listGeofences(txn *badger.Txn) ([]*api.Geofence, error) {
+	var fences []*api.Geofence
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(geofencePrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != geofenceMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		var fence = &api.Geofence{}
+		if err := proto.Unmarshal(res, fence); err != nil {
+			return nil, err
+		}
+		fences = append(fences, fence)
+	}
+	return fences, nil
+}
+
+func GetGeofenceEvents(db *badger.DB, geofence, key string, from, to int64) ([]*api.GeofenceEvent, error) {
+	if to == 0 {
+		to = math.MaxInt64
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	var events []*api.GeofenceEvent
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(geofenceEventPrefix)
+	for iter.Seek([]byte(fmt.Sprintf("%s%020d", geofenceEventPrefix, from))); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != geofenceEventMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var event = &api.GeofenceEvent{}
+		if err := proto.Unmarshal(res, event); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if event.TimestampUnix > to {
+			break
+		}
+		if geofence != "" && event.Geofence != geofence {
+			continue
+		}
+		if key != "" && event.Object.Key != key {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// evaluateGeofences persists & returns an event for each geofence the object entered or exited(see geofenceTransitions)
+func evaluateGeofences(txn *badger.Txn, obj *api.Object, previous *api.ObjectDetail, timestamp int64) ([]*api.GeofenceEvent, error) {
+	fences, err := listGeofences(txn)
+	if err != nil {
+		return nil, err
+	}
+	events := geofenceTransitions(fences, obj, previous, timestamp)
+	if err := setGeofenceEvents(txn.SetEntry, events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// geofenceTransitions compares the objects previous & current location against every geofence and returns an event for each geofence the object entered or exited.
+// obj is nil if the object was deleted or expired- it exits every geofence its previous location was inside of.
+func geofenceTransitions(fences []*api.Geofence, obj *api.Object, previous *api.ObjectDetail, timestamp int64) []*api.GeofenceEvent {
+	var events []*api.GeofenceEvent
+	for _, fence := range fences {
+		wasInside := previous != nil && previous.Object != nil && previous.Object.Point != nil && helpers.GeofenceContains(fence, previous.Object)
+		inside := obj != nil && helpers.GeofenceContains(fence, obj)
+		if wasInside == inside {
+			continue
+		}
+		event := &api.GeofenceEvent{
+			Geofence:      fence.Name,
+			Object:        obj,
+			Transition:    api.Transition_Enter,
+			TimestampUnix: timestamp,
+		}
+		if wasInside {
+			event.Transition = api.Transition_Exit
+			if obj == nil {
+				event.Object = previous.Object
+			}
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// setGeofenceEvents persists the events with set(a transaction or write batch)
+func setGeofenceEvents(set func(e *badger.Entry) error, events []*api.GeofenceEvent) error {
+	var expires uint64
+	if retention := config.Config.GetDuration("GEODB_GEOFENCE_EVENT_RETENTION"); retention > 0 {
+		expires = uint64(time.Now().Add(retention).Unix())
+	}
+	for _, event := range events {
+		bits, err := proto.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := set(&badger.Entry{
+			Key:       geofenceEventKey(event),
+			Value:     bits,
+			UserMeta:  geofenceEventMeta,
+			ExpiresAt: expires,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
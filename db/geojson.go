@@ -0,0 +1,61 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	geojson "github.com/paulmach/go.geojson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportGeoJSON returns a json encoded GeoJSON FeatureCollection of the objects that match every given selector(see selectObjects)
+func ExportGeoJSON(db *badger.DB, bound *api.Bound, polygon *api.Polygon, keys []string, prefix, rgex string, filter *api.MetadataFilter, trackerEvents bool) ([]byte, error) {
+	collection := geojson.NewFeatureCollection()
+	if err := selectObjects(db, bound, polygon, keys, prefix, rgex, filter, func(key string, obj *api.ObjectDetail, cursor string) error {
+		feature, err := helpers.ObjectFeature(obj, trackerEvents)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to convert %s to a feature: %s", key, err.Error())
+		}
+		collection.AddFeature(feature)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	bits, err := collection.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode feature collection: %s", err.Error())
+	}
+	return bits, nil
+}
+
+// ImportGeoJSON sets an object per feature of the json encoded GeoJSON FeatureCollection using the mapping.
+// It returns a result per feature in the same order as the features- features that can't be converted to an object fail without affecting the others.
+func ImportGeoJSON(db *badger.DB, maps *maps.Client, hub *stream.Hub, data []byte, mapping helpers.FeatureMapping) ([]*api.SetResult, error) {
+	collection, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode feature collection: %s", err.Error())
+	}
+	results := make([]*api.SetResult, len(collection.Features))
+	var objects []*api.Object
+	var indexes []int
+	for i, feature := range collection.Features {
+		obj, err := helpers.FeatureObject(feature, mapping)
+		if err != nil {
+			results[i] = &api.SetResult{
+				Error: err.Error(),
+			}
+			continue
+		}
+		objects = append(objects, obj)
+		indexes = append(indexes, i)
+	}
+	if len(objects) > 0 {
+		for i, result := range SetBatch(db, maps, hub, objects) {
+			results[indexes[i]] = result
+		}
+	}
+	return results, nil
+}
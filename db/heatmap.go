@@ -0,0 +1,73 @@
+package db
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+)
+
+type heatmapCell struct {
+	count   int64
+	x, y, z float64
+}
+
+// Heatmap counts the objects within the bound per geohash cell of the given precision- or per map tile at the zoom level if precision is zero.
+// If centroids is true, the centroid of the objects in each cell is computed as well.
+func Heatmap(db *badger.DB, bound *api.Bound, precision, zoom int, prefix string, filter *api.MetadataFilter, centroids bool) ([]*api.HeatmapCell, error) {
+	if bound.Center == nil {
+		return nil, status.Error(codes.InvalidArgument, "bound center is required")
+	}
+	cells := map[string]*heatmapCell{}
+	if _, err := ScanPrefixBoundFunc(db, bound, prefix, filter, Page{}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		id := heatmapCellID(obj.Object.Point, precision, zoom)
+		cell, ok := cells[id]
+		if !ok {
+			cell = &heatmapCell{}
+			cells[id] = cell
+		}
+		cell.count++
+		if centroids {
+			//sum the points as vectors so that the centroid of cells crossing the antimeridian is correct
+			lat, lon := obj.Object.Point.Lat*math.Pi/180, obj.Object.Point.Lon*math.Pi/180
+			cell.x += math.Cos(lat) * math.Cos(lon)
+			cell.y += math.Cos(lat) * math.Sin(lon)
+			cell.z += math.Sin(lat)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var heatmap []*api.HeatmapCell
+	for id, cell := range cells {
+		c := &api.HeatmapCell{
+			Cell:  id,
+			Count: cell.count,
+		}
+		if centroids {
+			c.Centroid = &api.Point{
+				Lat: math.Atan2(cell.z, math.Hypot(cell.x, cell.y)) * 180 / math.Pi,
+				Lon: math.Atan2(cell.y, cell.x) * 180 / math.Pi,
+			}
+		}
+		heatmap = append(heatmap, c)
+	}
+	sort.Slice(heatmap, func(i, j int) bool {
+		return heatmap[i].Cell < heatmap[j].Cell
+	})
+	return heatmap, nil
+}
+
+// heatmapCellID returns the geohash of the point at the precision- or the z/x/y index of the map tile containing the point if precision is zero
+func heatmapCellID(point *api.Point, precision, zoom int) string {
+	if precision > 0 {
+		return geo.NewPointFromLatLng(point.Lat, point.Lon).GeoHash(precision)
+	}
+	x, y := helpers.TileIndex(point, uint64(zoom))
+	return fmt.Sprintf("%d/%d/%d", zoom, x, y)
+}
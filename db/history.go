@@ -0,0 +1,132 @@
+package db
+
+import (
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"time"
+)
+
+const historyPrefix = "geodb_history_"
+
+// historyKeyPrefix is the prefix of all history entries of the object. The keys length keeps keys that share a prefix apart.
+func historyKeyPrefix(key string) []byte {
+	return []byte(fmt.Sprintf("%s%d_%s_", historyPrefix, len(key), key))
+}
+
+// historyTimeKey is the prefix of all history entries of the object that were recorded at timestamp
+func historyTimeKey(key string, timestamp int64) []byte {
+	return append(historyKeyPrefix(key), []byte(fmt.Sprintf("%020d", timestamp))...)
+}
+
+// historyKey orders history entries by timestamp. the nanosecond suffix keeps samples that are recorded within the same second apart
+func historyKey(key string, timestamp int64) []byte {
+	return append(historyTimeKey(key, timestamp), []byte(fmt.Sprintf("_%020d", time.Now().UnixNano()))...)
+}
+
+// setHistory records the objects location in its history
+func setHistory(txn *badger.Txn, obj *api.Object) error {
+	bits, err := proto.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var expires uint64
+	if retention := config.Config.GetDuration("GEODB_HISTORY_RETENTION"); retention > 0 {
+		expires = uint64(time.Now().Add(retention).Unix())
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:       historyKey(obj.Key, obj.UpdatedUnix),
+		Value:     bits,
+		UserMeta:  historyMeta,
+		ExpiresAt: expires,
+	})
+}
+
+func GetTrajectory(db *badger.DB, key string, from, to int64) ([]*api.Object, error) {
+	if to == 0 {
+		to = math.MaxInt64
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	var objects []*api.Object
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := historyKeyPrefix(key)
+	for iter.Seek(historyTimeKey(key, from)); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != historyMeta {
+			continue
+		}
+		obj, err := unmarshalHistory(item)
+		if err != nil {
+			return nil, err
+		}
+		if obj.UpdatedUnix > to {
+			break
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// GetPointAt returns the objects location at the given time. The location is linearly interpolated between the samples before & after the timestamp(see helpers.InterpolatePoint).
+// If there is no sample after the timestamp, the latest known location is returned.
+func GetPointAt(db *badger.DB, key string, timestamp int64) (*api.Point, bool, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	prefix := historyKeyPrefix(key)
+	// '~' sorts after the nanosecond suffix of every entry recorded at timestamp
+	before, err := seekHistory(txn, prefix, append(historyTimeKey(key, timestamp), '~'), true)
+	if err != nil {
+		return nil, false, err
+	}
+	if before == nil {
+		return nil, false, status.Errorf(codes.NotFound, "no location history for %s at %d", key, timestamp)
+	}
+	if before.UpdatedUnix == timestamp {
+		return before.Point, false, nil
+	}
+	after, err := seekHistory(txn, prefix, historyTimeKey(key, timestamp), false)
+	if err != nil {
+		return nil, false, err
+	}
+	if after == nil {
+		return before.Point, false, nil
+	}
+	ratio := float64(timestamp-before.UpdatedUnix) / float64(after.UpdatedUnix-before.UpdatedUnix)
+	return helpers.InterpolatePoint(before.Point, after.Point, ratio), true, nil
+}
+
+// seekHistory returns the first history entry at or before(reverse) / after the seek key
+func seekHistory(txn *badger.Txn, prefix, seek []byte, reverse bool) (*api.Object, error) {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(seek); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != historyMeta {
+			continue
+		}
+		return unmarshalHistory(item)
+	}
+	return nil, nil
+}
+
+func unmarshalHistory(item *badger.Item) (*api.Object, error) {
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+	}
+	var obj = &api.Object{}
+	if err := proto.Unmarshal(res, obj); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+	}
+	return obj, nil
+}
@@ -0,0 +1,308 @@
+package db
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+)
+
+const (
+	geohashPrefix    = "geodb_geohash_"
+	radiusPrefix     = "geodb_radius_"
+	indexVersionKey  = "geodb_index_version"
+	indexVersion     = "5"
+	geohashPrecision = 12
+	//maxCoverCells is the upper limit of geohash cells visited for a single bound
+	maxCoverCells = 64
+)
+
+func geohashKey(hash, key string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s", geohashPrefix, hash, key))
+}
+
+func radiusKey(radius int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", radiusPrefix, radius))
+}
+
+func objectGeohash(obj *api.Object) string {
+	return geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon).GeoHash(geohashPrecision)
+}
+
+// setGeohashIndex writes the objects geohash index entry & removes the entry of its previous location
+func setGeohashIndex(txn *badger.Txn, obj *api.Object, previous *api.ObjectDetail) error {
+	hash := objectGeohash(obj)
+	if previous != nil && previous.Object != nil && previous.Object.Point != nil {
+		if old := objectGeohash(previous.Object); old != hash {
+			if err := txn.Delete(geohashKey(old, obj.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:       geohashKey(hash, obj.Key),
+		UserMeta:  geohashMeta,
+		ExpiresAt: uint64(obj.ExpiresUnix),
+	})
+}
+
+// setRadiusIndex records the objects radius so that scans can pad their bounds by the largest object radius.
+// Radius entries are never removed, so the padding may be larger than necessary but never too small.
+func setRadiusIndex(db *badger.DB, txn *badger.Txn, obj *api.Object) error {
+	if err := db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(radiusKey(obj.Radius))
+		return err
+	}); err == nil {
+		return nil
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:      radiusKey(obj.Radius),
+		UserMeta: radiusMeta,
+	})
+}
+
+// maxObjectRadius returns the largest radius of any object that has been written to the database
+func maxObjectRadius(txn *badger.Txn) float64 {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Reverse = true
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	prefix := []byte(radiusPrefix)
+	for iter.Seek(append(prefix, 0xFF)); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != radiusMeta {
+			continue
+		}
+		var radius int64
+		if _, err := fmt.Sscanf(string(item.Key()[len(prefix):]), "%d", &radius); err != nil {
+			continue
+		}
+		return float64(radius)
+	}
+	return 0
+}
+
+// boundCandidates returns the area that has to be scanned in the geohash index to find every object that may match the bound
+func boundCandidates(txn *badger.Txn, bound *api.Bound) *geo.Bound {
+	radius := bound.Radius
+	if bound.Mode == api.BoundMode_CirclesIntersect {
+		radius += maxObjectRadius(txn)
+	}
+	return geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), radius)
+}
+
+// deleteGeohashIndex removes the geohash index entry of the object stored under key(if it exists)
+func deleteGeohashIndex(txn *badger.Txn, key string) error {
+	obj, err := getObject(txn, key)
+	if err != nil {
+		return err
+	}
+	if obj == nil || obj.Object == nil || obj.Object.Point == nil {
+		return nil
+	}
+	return txn.Delete(geohashKey(objectGeohash(obj.Object), key))
+}
+
+// geohashCover returns the geohash cells that cover the bound. The precision of the cells is chosen so that no more than maxCoverCells are returned
+func geohashCover(bound *geo.Bound) []string {
+	var ranges [][2]float64
+	if bound.West() > bound.East() {
+		//the bound crosses the antimeridian
+		ranges = [][2]float64{{bound.West(), 180}, {-180, bound.East()}}
+	} else {
+		ranges = [][2]float64{{bound.West(), bound.East()}}
+	}
+	precision := geohashPrecision
+	for ; precision > 1; precision-- {
+		width, height := geohashCellSize(precision)
+		count := 0
+		for _, r := range ranges {
+			count += (int(math.Ceil((r[1]-r[0])/width)) + 1) * (int(math.Ceil((bound.North()-bound.South())/height)) + 1)
+		}
+		if count <= maxCoverCells {
+			break
+		}
+	}
+	width, height := geohashCellSize(precision)
+	seen := map[string]struct{}{}
+	var cells []string
+	for _, r := range ranges {
+		for lat := bound.South(); ; lat += height {
+			lat = math.Min(lat, bound.North())
+			for lon := r[0]; ; lon += width {
+				lon = math.Min(lon, r[1])
+				hash := geo.NewPointFromLatLng(lat, lon).GeoHash(precision)
+				if _, ok := seen[hash]; !ok {
+					seen[hash] = struct{}{}
+					cells = append(cells, hash)
+				}
+				if lon >= r[1] {
+					break
+				}
+			}
+			if lat >= bound.North() {
+				break
+			}
+		}
+	}
+	return cells
+}
+
+// geohashCellSize returns the width & height in degrees of a geohash cell of the given precision
+func geohashCellSize(precision int) (float64, float64) {
+	bits := uint(5 * precision)
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 360 / math.Pow(2, float64(lonBits)), 180 / math.Pow(2, float64(latBits))
+}
+
+// scanGeohashIndex calls fn with every object that has an index entry in the cells covering the bound & matches match.
+// The objects are candidates only- callers are responsible for the exact geometric check.
+// The objects are visited in geohash & then key order. Every object passed to fn has been added to the page.
+func scanGeohashIndex(txn *badger.Txn, bound *geo.Bound, p *pager, match func(key string, obj *api.ObjectDetail) bool, fn func(key string, obj *api.ObjectDetail) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	cells := geohashCover(bound)
+	sort.Strings(cells)
+	seen := map[string]struct{}{}
+	for _, cell := range cells {
+		prefix := []byte(geohashPrefix + cell)
+		start, ok := p.seek(prefix)
+		if !ok {
+			continue
+		}
+		for iter.Seek(start); iter.ValidForPrefix(prefix); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != geohashMeta {
+				continue
+			}
+			position := string(item.Key())
+			key := position[len(geohashPrefix)+geohashPrecision+1:]
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			i, err := txn.Get([]byte(key))
+			if err != nil {
+				if err == badger.ErrKeyNotFound {
+					continue
+				}
+				return status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+			}
+			if i.UserMeta() != objectMeta {
+				continue
+			}
+			res, err := i.ValueCopy(nil)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			}
+			var obj = &api.ObjectDetail{}
+			if err := proto.Unmarshal(res, obj); err != nil {
+				return status.Errorf(codes.Internal, "%s failed to unmarshal protobuf: %s", key, err.Error())
+			}
+			if !match(key, obj) {
+				continue
+			}
+			if err := p.add(position); err != nil {
+				return err
+			}
+			if err := fn(key, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReindexGeohash builds the geohash, radius, tracker & expiry indexes for objects that were written before the current index version existed. It is a no-op once the index has been built.
+func ReindexGeohash(db *badger.DB) error {
+	var version []byte
+	if err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(indexVersionKey))
+		if err != nil {
+			return err
+		}
+		version, err = item.ValueCopy(nil)
+		return err
+	}); err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	if string(version) == indexVersion {
+		return nil
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	if err := db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != objectMeta {
+				continue
+			}
+			res, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var obj = &api.ObjectDetail{}
+			if err := proto.Unmarshal(res, obj); err != nil {
+				return err
+			}
+			if obj.Object == nil {
+				continue
+			}
+			if obj.Object.ExpiresUnix > 0 {
+				if err := wb.SetEntry(&badger.Entry{
+					Key:      expiryKey(obj.Object.ExpiresUnix, string(item.Key())),
+					Value:    res,
+					UserMeta: expiryMeta,
+				}); err != nil {
+					return err
+				}
+			}
+			if obj.Object.Point == nil {
+				continue
+			}
+			if err := wb.SetEntry(&badger.Entry{
+				Key:       geohashKey(objectGeohash(obj.Object), string(item.Key())),
+				UserMeta:  geohashMeta,
+				ExpiresAt: item.ExpiresAt(),
+			}); err != nil {
+				return err
+			}
+			if err := wb.SetEntry(&badger.Entry{
+				Key:      radiusKey(obj.Object.Radius),
+				UserMeta: radiusMeta,
+			}); err != nil {
+				return err
+			}
+			for _, t := range obj.Object.GetTracking().GetTrackers() {
+				if err := wb.SetEntry(&badger.Entry{
+					Key:       trackerKey(t.TargetObjectKey, obj.Object.Key),
+					UserMeta:  trackerMeta,
+					ExpiresAt: item.ExpiresAt(),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := wb.SetEntry(&badger.Entry{
+		Key:   []byte(indexVersionKey),
+		Value: []byte(indexVersion),
+	}); err != nil {
+		return err
+	}
+	return wb.Flush()
+}
@@ -7,62 +7,53 @@ import (
 	"regexp"
 )
 
-func GetKeys(db *badger.DB) []string {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	keys := []string{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		keys = append(keys, string(item.Key()))
-	}
-	iter.Close()
-	return keys
+func GetKeys(db *badger.DB, page Page) ([]string, string, error) {
+	return getKeysMatching(db, "", func(key string) bool { return true }, page)
 }
 
-func GetPrefixKeys(db *badger.DB, prefix string) []string {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	keys := []string{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		keys = append(keys, string(item.Key()))
+func GetPrefixKeys(db *badger.DB, prefix string, page Page) ([]string, string, error) {
+	return getKeysMatching(db, prefix, func(key string) bool { return true }, page)
+}
+
+func GetRegexKeys(db *badger.DB, regex string, page Page) ([]string, string, error) {
+	reg, err := regexp.Compile(regex)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
 	}
-	iter.Close()
-	return keys
+	return getKeysMatching(db, "", reg.MatchString, page)
 }
 
-func GetRegexKeys(db *badger.DB, regex string) ([]string, error) {
+// getKeysMatching returns the keys of the objects with the prefix that match match in key order
+func getKeysMatching(db *badger.DB, prefix string, match func(key string) bool, page Page) ([]string, string, error) {
+	p, err := newPager(page)
+	if err != nil {
+		return nil, "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
 	keys := []string{}
+	start, ok := p.seek([]byte(prefix))
+	if !ok {
+		return keys, "", nil
+	}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
 	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	defer iter.Close()
+	for iter.Seek(start); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if item.UserMeta() != objectMeta {
 			continue
 		}
-		match, err := regexp.MatchString(string(regex), string(item.Key()))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+		key := string(item.Key())
+		if !match(key) {
+			continue
 		}
-		if match {
-			keys = append(keys, string(item.Key()))
+		if err := p.add(key); err != nil {
+			break
 		}
+		keys = append(keys, key)
 	}
-	iter.Close()
-	return keys, nil
+	next, _ := p.done(nil)
+	return keys, next, nil
 }
@@ -0,0 +1,16 @@
+package db
+
+// badger UserMeta values used to tell the different kinds of entries apart. 2-5 are used by the maps cache.
+const (
+	objectMeta        byte = 1
+	geohashMeta       byte = 6
+	radiusMeta        byte = 7
+	geofenceMeta      byte = 8
+	geofenceEventMeta byte = 9
+	trackerMeta       byte = 10
+	historyMeta       byte = 11
+	expiryMeta        byte = 12
+	changeLogMeta     byte = 13
+	metadataFieldMeta byte = 14
+	metadataIndexMeta byte = 15
+)
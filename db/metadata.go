@@ -0,0 +1,285 @@
+package db
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+)
+
+const (
+	metadataFieldPrefix = "geodb_metafield_"
+	metadataIndexPrefix = "geodb_metaindex_"
+	// metadataFieldsVersionKey is written whenever fields are declared or removed. Writes that index metadata read it, so a write that
+	// raced a declaration conflicts & is retried with the current fields.
+	metadataFieldsVersionKey = "geodb_metafields_version"
+)
+
+func metadataFieldKey(field string) []byte {
+	return []byte(metadataFieldPrefix + field)
+}
+
+// metadataValuePrefix is the prefix of all index entries of objects with the fields value. Lengths keep fields & values that share a prefix apart.
+func metadataValuePrefix(field, value string) []byte {
+	return []byte(fmt.Sprintf("%s%d_%s_%d_%s_", metadataIndexPrefix, len(field), field, len(value), value))
+}
+
+func metadataFieldIndexPrefix(field string) []byte {
+	return []byte(fmt.Sprintf("%s%d_%s_", metadataIndexPrefix, len(field), field))
+}
+
+func metadataIndexKey(field, value, key string) []byte {
+	return append(metadataValuePrefix(field, value), []byte(key)...)
+}
+
+// CreateMetadataIndex declares the metadata field as indexed & indexes the field of every existing object.
+// The declaration is committed first so that objects written from then on index the field themselves.
+func CreateMetadataIndex(db *badger.DB, field string) error {
+	if field == "" {
+		return status.Error(codes.InvalidArgument, "field is required")
+	}
+	if err := db.Update(func(txn *badger.Txn) error {
+		if err := txn.SetEntry(&badger.Entry{
+			Key:      metadataFieldKey(field),
+			UserMeta: metadataFieldMeta,
+		}); err != nil {
+			return err
+		}
+		return txn.Set([]byte(metadataFieldsVersionKey), nil)
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to index metadata: %s", err.Error())
+	}
+	// existing objects are re-read in the transactions that index them so that an object that is set concurrently never keeps a stale entry
+	var keys []string
+	backfill := func() error {
+		return retryConflicts(func() error {
+			txn := db.NewTransaction(true)
+			defer txn.Discard()
+			for _, key := range keys {
+				obj, err := getObject(txn, key)
+				if err != nil {
+					return err
+				}
+				val, ok := obj.GetObject().GetMetadata()[field]
+				if !ok {
+					continue
+				}
+				if err := txn.SetEntry(&badger.Entry{
+					Key:       metadataIndexKey(field, val, key),
+					UserMeta:  metadataIndexMeta,
+					ExpiresAt: uint64(obj.Object.ExpiresUnix),
+				}); err != nil {
+					return err
+				}
+			}
+			return commit(txn)
+		})
+	}
+	if err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != objectMeta {
+				continue
+			}
+			keys = append(keys, string(item.KeyCopy(nil)))
+			if len(keys) == setBatchSize {
+				if err := backfill(); err != nil {
+					return err
+				}
+				keys = nil
+			}
+		}
+		if len(keys) > 0 {
+			return backfill()
+		}
+		return nil
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to index metadata: %s", err.Error())
+	}
+	return nil
+}
+
+// DeleteMetadataIndex removes the fields from the indexed fields & deletes their index entries
+func DeleteMetadataIndex(db *badger.DB, fields []string) error {
+	if err := db.Update(func(txn *badger.Txn) error {
+		for _, field := range fields {
+			if err := txn.Delete(metadataFieldKey(field)); err != nil {
+				return err
+			}
+		}
+		return txn.Set([]byte(metadataFieldsVersionKey), nil)
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete metadata index: %s", err.Error())
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	if err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for _, field := range fields {
+			prefix := metadataFieldIndexPrefix(field)
+			for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+				if err := wb.Delete(iter.Item().KeyCopy(nil)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete metadata index: %s", err.Error())
+	}
+	if err := wb.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete metadata index: %s", err.Error())
+	}
+	return nil
+}
+
+func ListMetadataIndexes(db *badger.DB) ([]string, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	fields, err := indexedMetadataFields(txn)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list metadata indexes: %s", err.Error())
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func indexedMetadataFields(txn *badger.Txn) ([]string, error) {
+	// reading the version makes a write transaction conflict with declarations that commit while it is open
+	if _, err := txn.Get([]byte(metadataFieldsVersionKey)); err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var fields []string
+	prefix := []byte(metadataFieldPrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != metadataFieldMeta {
+			continue
+		}
+		fields = append(fields, string(item.Key()[len(prefix):]))
+	}
+	return fields, nil
+}
+
+// setMetadataIndex writes the index entries of the objects indexed metadata fields & removes the entries of its previous values
+func setMetadataIndex(txn *badger.Txn, obj *api.Object, previous *api.ObjectDetail) error {
+	fields, err := indexedMetadataFields(txn)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if old, ok := previous.GetObject().GetMetadata()[field]; ok {
+			if val, ok := obj.Metadata[field]; !ok || val != old {
+				if err := txn.Delete(metadataIndexKey(field, old, obj.Key)); err != nil {
+					return err
+				}
+			}
+		}
+		if val, ok := obj.Metadata[field]; ok {
+			if err := txn.SetEntry(&badger.Entry{
+				Key:       metadataIndexKey(field, val, obj.Key),
+				UserMeta:  metadataIndexMeta,
+				ExpiresAt: uint64(obj.ExpiresUnix),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteMetadataIndex removes the index entries of the object(if it exists)
+func deleteMetadataIndex(txn *badger.Txn, previous *api.ObjectDetail) error {
+	if previous == nil || previous.Object == nil {
+		return nil
+	}
+	fields, err := indexedMetadataFields(txn)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if val, ok := previous.Object.Metadata[field]; ok {
+			if err := txn.Delete(metadataIndexKey(field, val, previous.Object.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// metadataCandidates returns the keys of the objects that may match the filter using the index of the first Equal or In condition on an indexed field.
+// ok is false if the filter has no such condition, in which case every object is a candidate.
+func metadataCandidates(txn *badger.Txn, filter *api.MetadataFilter) (keys []string, ok bool, err error) {
+	if len(filter.GetConditions()) == 0 {
+		return nil, false, nil
+	}
+	fields, err := indexedMetadataFields(txn)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, c := range filter.Conditions {
+		if c.Op != api.MetadataOperator_Equal && c.Op != api.MetadataOperator_In {
+			continue
+		}
+		indexed := false
+		for _, field := range fields {
+			if field == c.Field {
+				indexed = true
+				break
+			}
+		}
+		if !indexed {
+			continue
+		}
+		values := c.Values
+		if c.Op == api.MetadataOperator_Equal && len(values) > 1 {
+			values = values[:1]
+		}
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		seen := map[string]struct{}{}
+		keys = []string{}
+		for _, val := range values {
+			prefix := metadataValuePrefix(c.Field, val)
+			for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+				item := iter.Item()
+				if item.UserMeta() != metadataIndexMeta {
+					continue
+				}
+				key := string(item.Key()[len(prefix):])
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		return keys, true, nil
+	}
+	return nil, false, nil
+}
+
+// validateFilter returns an InvalidArgument error if the filter is invalid
+func validateFilter(filter *api.MetadataFilter) error {
+	if err := helpers.ValidateMetadataFilter(filter); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
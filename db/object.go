@@ -1,7 +1,6 @@
 package db
 
 import (
-	"context"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
 	"github.com/autom8ter/geodb/maps"
@@ -9,24 +8,83 @@ import (
 	"github.com/autom8ter/geodb/stream"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/gogo/protobuf/proto"
-	geo "github.com/paulmach/go.geo"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
+// maxConflictRetries is the max number of times a transaction is retried after it conflicted with a concurrent write of the same keys
+const maxConflictRetries = 100
+
+// retryConflicts calls fn until it doesn't fail with a transaction conflict. fn must run a new transaction on every call.
+// Concurrent writes of the same key are last-writer-wins- a write that conflicts is retried on top of the committed one.
+func retryConflicts(fn func() error) error {
+	var err error
+	for i := 0; i < maxConflictRetries; i++ {
+		if err = fn(); err != badger.ErrConflict {
+			return err
+		}
+	}
+	return status.Errorf(codes.Aborted, "failed to commit transaction: %s", err.Error())
+}
+
+// commit commits the transaction. Conflicts are returned as is so that they can be retried(see retryConflicts).
+func commit(txn *badger.Txn) error {
+	if err := txn.Commit(); err != nil {
+		if err == badger.ErrConflict {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to commit transaction: %s", err.Error())
+	}
+	return nil
+}
+
 func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*api.ObjectDetail, error) {
+	detail, trackerEvents, err := enrichObject(db, maps, obj)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		event          *api.ObjectEvent
+		geofenceEvents []*api.GeofenceEvent
+	)
+	if err := retryConflicts(func() error {
+		// tracker events are appended to the detail by setObject
+		detail.TrackerEvents = nil
+		txn := db.NewTransaction(true)
+		defer txn.Discard()
+		var err error
+		event, geofenceEvents, err = setObject(db, txn, detail, trackerEvents)
+		if err != nil {
+			return err
+		}
+		return commitChangeLog(db, txn.SetEntry, func() error {
+			return commit(txn)
+		}, event)
+	}); err != nil {
+		return nil, err
+	}
+	publishObject(db, maps, hub, event, geofenceEvents)
+	return detail, nil
+}
+
+// enrichObject validates the object & computes its tracker events, address & timezone. Tracker transitions are set once the previous object is known.
+func enrichObject(db *badger.DB, maps *maps.Client, obj *api.Object) (*api.ObjectDetail, map[string]*api.TrackerEvent, error) {
+	if obj == nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "object is required")
+	}
 	if err := obj.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	if obj.UpdatedUnix == 0 {
 		obj.UpdatedUnix = time.Now().Unix()
 	}
 	metrics.GaugeObjectLocation(obj.Key, obj.Point)
-	point1 := geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon)
 	mu := &sync.Mutex{}
 	wg := &sync.WaitGroup{}
 	var events = map[string]*api.TrackerEvent{}
@@ -35,51 +93,28 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 			wg.Add(1)
 			go func(val *api.Object, tracker *api.ObjectTracker) {
 				defer wg.Done()
-				txn := db.NewTransaction(false)
-				item, err := txn.Get([]byte(tracker.GetTargetObjectKey()))
-				if err != nil {
+				// the target is read in its own transaction so that none is open while google maps is requested
+				var target *api.ObjectDetail
+				if err := db.View(func(txn *badger.Txn) error {
+					var err error
+					target, err = getObject(txn, tracker.GetTargetObjectKey())
+					return err
+				}); err != nil {
 					log.Error(err.Error())
 					return
 				}
-				res, err := item.ValueCopy(nil)
-				if err != nil {
-					log.Error(err.Error())
+				if target == nil {
+					log.Errorf("tracker target object %s not found", tracker.GetTargetObjectKey())
 					return
 				}
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
+				trackerEvent, err := newTrackerEvent(maps, val, tracker, target.Object)
+				if err != nil {
 					log.Error(err.Error())
 					return
 				}
-				point2 := geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)
-				dist := point1.GeoDistanceFrom(point2, true)
-				trackerEvent := &api.TrackerEvent{
-					Object:        obj.Object,
-					Distance:      dist,
-					Inside:        dist <= float64(val.Radius+obj.Object.Radius),
-					TimestampUnix: val.UpdatedUnix,
-				}
-				if maps != nil && val.Tracking != nil {
-					directions, eta, dist, err := maps.TravelDetail(context.Background(), val.Point, obj.Object.Point, helpers.ToTravelMode(val.GetTracking().GetTravelMode()))
-					if err != nil {
-						return
-					} else {
-						trackerEvent.Direction = &api.Directions{}
-						if tracker.TrackDirections {
-							trackerEvent.Direction.HtmlDirections = directions
-						}
-						if tracker.TrackEta {
-							trackerEvent.Direction.Eta = int64(eta)
-						}
-						if tracker.TrackDistance {
-							trackerEvent.Direction.TravelDist = int64(dist)
-						}
-					}
-				}
 				mu.Lock()
-				events[obj.Object.Key] = trackerEvent
+				events[target.Object.Key] = trackerEvent
 				mu.Unlock()
-				txn.Discard()
 			}(obj, t)
 		}
 	}
@@ -121,153 +156,430 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 	if zone != "" {
 		detail.Timezone = zone
 	}
-	if len(events) > 0 {
-		for _, event := range events {
+	return detail, events, nil
+}
+
+// setObject writes the object detail & its index, history & geofence entries in the transaction. The events are returned so they can be recorded in the change log(see commitChangeLog) & published once the transaction is committed.
+func setObject(db *badger.DB, txn *badger.Txn, detail *api.ObjectDetail, trackerEvents map[string]*api.TrackerEvent) (*api.ObjectEvent, []*api.GeofenceEvent, error) {
+	obj := detail.Object
+	previous, err := getObject(txn, obj.Key)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get previous object: %s", err.Error())
+	}
+	if len(trackerEvents) > 0 {
+		for _, event := range trackerEvents {
+			setTrackerTransition(event, previous)
 			detail.TrackerEvents = append(detail.TrackerEvents, event)
 		}
 	}
 
 	bits, err := proto.Marshal(detail)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err := setGeohashIndex(txn, obj, previous); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := setRadiusIndex(db, txn, obj); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := setTrackerIndex(txn, obj, previous); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := setMetadataIndex(txn, obj, previous); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := setExpiryIndex(txn, detail, bits, previous); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := setHistory(txn, obj); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to record object history: %s", err.Error())
 	}
-	txn := db.NewTransaction(true)
-	defer txn.Discard()
 	if err := txn.SetEntry(&badger.Entry{
 		Key:       []byte(obj.Key),
 		Value:     bits,
-		UserMeta:  1,
+		UserMeta:  objectMeta,
 		ExpiresAt: uint64(obj.ExpiresUnix),
 	}); err != nil {
+		return nil, nil, err
+	}
+	geofenceEvents, err := evaluateGeofences(txn, obj, previous, obj.UpdatedUnix)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to evaluate geofences: %s", err.Error())
+	}
+	event := &api.ObjectEvent{
+		Type:          api.EventType_Set,
+		Object:        detail,
+		TimestampUnix: time.Now().Unix(),
+		Previous:      previous.GetObject(),
+	}
+	return event, geofenceEvents, nil
+}
+
+// publishObject publishes the events of a committed object & updates the objects that track it
+func publishObject(db *badger.DB, maps *maps.Client, hub *stream.Hub, event *api.ObjectEvent, geofenceEvents []*api.GeofenceEvent) {
+	hub.PublishObjectEvent(event)
+	for _, e := range geofenceEvents {
+		hub.PublishGeofenceEvent(e)
+	}
+	updateTrackers(db, maps, hub, event.Object.Object.Key, event.Object.Object)
+}
+
+// getObject returns the object detail stored under key. It returns nil if the object doesn't exist.
+func getObject(txn *badger.Txn, key string) (*api.ObjectDetail, error) {
+	item, err := txn.Get([]byte(key))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
-	if err := txn.Commit(); err != nil {
+	if item.UserMeta() != objectMeta {
+		return nil, nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
 		return nil, err
 	}
-	hub.PublishObject(detail)
-	return detail, nil
+	var obj = &api.ObjectDetail{}
+	if err := proto.Unmarshal(res, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func Get(db *badger.DB, keys []string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	next, err := GetFunc(db, keys, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
 }
 
-func Get(db *badger.DB, keys []string) (map[string]*api.ObjectDetail, error) {
+// GetFunc calls fn with every object that Get returns as soon as it is found
+func GetFunc(db *badger.DB, keys []string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
 	if len(keys) == 0 {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer iter.Close()
-		for iter.Rewind(); iter.Valid(); iter.Next() {
-			item := iter.Item()
-			if item.UserMeta() != 1 {
-				continue
-			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "(keys) %s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
-				}
-				objects[string(item.Key())] = obj
-			}
+		return p.done(scanMetadata(txn, filter, p, func(key string) bool { return true }, p.emit(fn)))
+	}
+	emit := p.emit(fn)
+	return p.done(getKeys(txn, keys, p, func(key string, obj *api.ObjectDetail) error {
+		if !helpers.MetadataMatches(filter, obj.GetObject().GetMetadata()) {
+			return nil
 		}
-	} else {
-		for _, key := range keys {
-			i, err := txn.Get([]byte(key))
-			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "failed to get key: %s", err.Error())
-			}
-			if i.UserMeta() != 1 {
-				continue
-			}
-			res, err := i.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			var obj = &api.ObjectDetail{}
-			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "(all) failed to unmarshal protobuf: %s", err.Error())
-			}
-			objects[key] = obj
+		if err := p.add(key); err != nil {
+			return err
 		}
+		return emit(key, obj)
+	}))
+}
+
+func GetRegex(db *badger.DB, regex string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	next, err := GetRegexFunc(db, regex, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
 	}
-	return objects, nil
+	return objects, next, nil
 }
 
-func GetRegex(db *badger.DB, regex string) (map[string]*api.ObjectDetail, error) {
+// GetRegexFunc calls fn with every object that GetRegex returns as soon as it is found
+func GetRegexFunc(db *badger.DB, regex string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	reg, err := regexp.Compile(regex)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
+	return p.done(scanMetadata(txn, filter, p, reg.MatchString, p.emit(fn)))
+}
+
+func GetPrefix(db *badger.DB, prefix string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
 	objects := map[string]*api.ObjectDetail{}
+	next, err := GetPrefixFunc(db, prefix, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
+}
+
+// GetPrefixFunc calls fn with every object that GetPrefix returns as soon as it is found
+func GetPrefixFunc(db *badger.DB, prefix string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	return p.done(scanPrefix(txn, prefix, filter, p, p.emit(fn)))
+}
+
+// scanPrefix calls fn in key order with every object that has a key with the prefix & metadata matching the filter.
+// Every object passed to fn has been added to the page. fn is not called with objects of previous pages.
+func scanPrefix(txn *badger.Txn, prefix string, filter *api.MetadataFilter, p *pager, fn func(key string, obj *api.ObjectDetail) error) error {
+	hasPrefix := func(key string) bool { return strings.HasPrefix(key, prefix) }
+	candidates, ok, err := metadataCandidates(txn, filter)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read metadata index: %s", err.Error())
+	}
+	if ok {
+		return getCandidates(txn, candidates, filter, p, hasPrefix, fn)
+	}
+	start, ok := p.seek([]byte(prefix))
+	if !ok {
+		return nil
+	}
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	for iter.Seek(start); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != objectMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if !helpers.MetadataMatches(filter, obj.GetObject().GetMetadata()) {
+			continue
+		}
+		key := string(item.Key())
+		if err := p.add(key); err != nil {
+			return err
+		}
+		if err := fn(key, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanMetadata calls fn in key order with every object that has a key matching match & metadata matching the filter.
+// If the filter can be served from a metadata index, only the indexed candidates are read- otherwise every object is.
+// Every object passed to fn has been added to the page. fn is not called with objects of previous pages.
+func scanMetadata(txn *badger.Txn, filter *api.MetadataFilter, p *pager, match func(key string) bool, fn func(key string, obj *api.ObjectDetail) error) error {
+	candidates, ok, err := metadataCandidates(txn, filter)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read metadata index: %s", err.Error())
+	}
+	if ok {
+		return getCandidates(txn, candidates, filter, p, match, fn)
+	}
+	start, ok := p.seek(nil)
+	if !ok {
+		return nil
+	}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
 	iter := txn.NewIterator(opts)
 	defer iter.Close()
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	for iter.Seek(start); iter.Valid(); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if item.UserMeta() != objectMeta {
 			continue
 		}
-		match, err := regexp.MatchString(regex, string(item.Key()))
+		key := string(item.Key())
+		if !match(key) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
 		}
-		if match {
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			var obj = &api.ObjectDetail{}
-			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
-			}
-			objects[string(item.Key())] = obj
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return status.Errorf(codes.Internal, "%s failed to unmarshal protobuf: %s", key, err.Error())
+		}
+		if !helpers.MetadataMatches(filter, obj.GetObject().GetMetadata()) {
+			continue
+		}
+		if err := p.add(key); err != nil {
+			return err
+		}
+		if err := fn(key, obj); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
+// getCandidates calls fn with every candidate object that still exists & matches both match & the filter.
+// The candidates must be sorted. Every object passed to fn has been added to the page.
+func getCandidates(txn *badger.Txn, keys []string, filter *api.MetadataFilter, p *pager, match func(key string) bool, fn func(key string, obj *api.ObjectDetail) error) error {
+	for _, key := range keys {
+		if p.skip(key) || !match(key) {
+			continue
+		}
+		obj, err := getObject(txn, key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get key: %s %s", key, err.Error())
+		}
+		if obj == nil || !helpers.MetadataMatches(filter, obj.GetObject().GetMetadata()) {
+			continue
+		}
+		if err := p.add(key); err != nil {
+			return err
+		}
+		if err := fn(key, obj); err != nil {
+			return err
+		}
 	}
-	return objects, nil
+	return nil
 }
 
-func GetPrefix(db *badger.DB, prefix string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer iter.Close()
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
+// getKeys calls fn in key order with the objects stored under the keys, skipping keys of previous pages.
+// It returns an InvalidArgument error if one of the keys doesn't exist.
+func getKeys(txn *badger.Txn, keys []string, p *pager, fn func(key string, obj *api.ObjectDetail) error) error {
+	sorted := make([]string, len(keys))
+	copy(sorted, keys)
+	sort.Strings(sorted)
+	for i, key := range sorted {
+		if (i > 0 && key == sorted[i-1]) || p.skip(key) {
+			continue
+		}
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to get key: %s", err.Error())
+		}
+		if item.UserMeta() != objectMeta {
 			continue
 		}
 		res, err := item.ValueCopy(nil)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
 		}
 		var obj = &api.ObjectDetail{}
 		if err := proto.Unmarshal(res, obj); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+			return status.Errorf(codes.Internal, "(all) failed to unmarshal protobuf: %s", err.Error())
+		}
+		if err := fn(key, obj); err != nil {
+			return err
 		}
-		objects[string(item.Key())] = obj
 	}
-	return objects, nil
+	return nil
 }
 
-func Delete(db *badger.DB, keys []string) error {
-	txn := db.NewTransaction(true)
-	defer txn.Discard()
+// Delete removes the objects stored under keys along with their index entries. The key "*" deletes every object- geofences, metadata index declarations,
+// history & the change log are kept. Explicit keys are deleted in a single transaction, all objects in transactions of up to setBatchSize objects.
+func Delete(db *badger.DB, hub *stream.Hub, keys []string) error {
+	batches := [][]string{keys}
 	if len(keys) > 0 && keys[0] == "*" {
-		if err := db.DropAll(); err != nil {
-			return status.Errorf(codes.Internal, "failed to delete key: %s", err.Error())
+		all, _, err := GetKeys(db, Page{})
+		if err != nil {
+			return err
 		}
-	} else {
-		for _, key := range keys {
-			if err := txn.Delete([]byte(key)); err != nil {
-				return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+		batches = nil
+		for len(all) > 0 {
+			size := setBatchSize
+			if len(all) < size {
+				size = len(all)
 			}
+			batches = append(batches, all[:size])
+			all = all[size:]
 		}
 	}
-	if err := txn.Commit(); err != nil {
-		return status.Errorf(codes.Internal, "failed to delete keys %s", err.Error())
+	var (
+		events         []*api.ObjectEvent
+		geofenceEvents []*api.GeofenceEvent
+	)
+	for _, batch := range batches {
+		var (
+			batchEvents         []*api.ObjectEvent
+			batchGeofenceEvents []*api.GeofenceEvent
+		)
+		if err := retryConflicts(func() error {
+			txn := db.NewTransaction(true)
+			defer txn.Discard()
+			var err error
+			batchEvents, batchGeofenceEvents, err = deleteObjects(db, txn, batch)
+			if err != nil {
+				return err
+			}
+			return commitChangeLog(db, txn.SetEntry, func() error {
+				return commit(txn)
+			}, batchEvents...)
+		}); err != nil {
+			return err
+		}
+		events = append(events, batchEvents...)
+		geofenceEvents = append(geofenceEvents, batchGeofenceEvents...)
+	}
+	for _, event := range events {
+		hub.PublishObjectEvent(event)
+	}
+	for _, event := range geofenceEvents {
+		hub.PublishGeofenceEvent(event)
+	}
+	for _, event := range events {
+		updateTrackers(db, nil, hub, event.Object.Object.Key, nil)
 	}
 	return nil
 }
+
+// deleteObjects removes the objects stored under keys & their index entries in the transaction. A Delete event is returned for every object that existed
+// along with an Exit event for every geofence it was inside of.
+func deleteObjects(db *badger.DB, txn *badger.Txn, keys []string) ([]*api.ObjectEvent, []*api.GeofenceEvent, error) {
+	var (
+		events         []*api.ObjectEvent
+		geofenceEvents []*api.GeofenceEvent
+	)
+	now := time.Now().Unix()
+	for _, key := range keys {
+		previous, err := getObject(txn, key)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to get object: %s %s", key, err.Error())
+		}
+		if err := deleteGeohashIndex(txn, key); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to delete index: %s %s", key, err.Error())
+		}
+		if err := deleteTrackerIndex(txn, key); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to delete index: %s %s", key, err.Error())
+		}
+		if err := deleteExpiryIndex(txn, previous); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to delete index: %s %s", key, err.Error())
+		}
+		if err := deleteMetadataIndex(txn, previous); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to delete index: %s %s", key, err.Error())
+		}
+		if err := txn.Delete([]byte(key)); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+		}
+		if previous != nil {
+			event := &api.ObjectEvent{
+				Type:          api.EventType_Delete,
+				Object:        previous,
+				TimestampUnix: now,
+			}
+			events = append(events, event)
+			exits, err := evaluateGeofences(txn, nil, previous, now)
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to evaluate geofences: %s", err.Error())
+			}
+			geofenceEvents = append(geofenceEvents, exits...)
+		}
+	}
+	return events, geofenceEvents, nil
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"encoding/base64"
+	"errors"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// Page limits a query to Limit results(zero if no limit) & continues it after the position encoded in Cursor.
+// Results are ordered by key- except for scans of the geohash index, which are ordered by geohash & then by key.
+type Page struct {
+	Limit  int64
+	Cursor string
+}
+
+// ObjectFunc is called with every object a query returns & the cursor that continues the query after the object
+type ObjectFunc func(key string, obj *api.ObjectDetail, cursor string) error
+
+// collectObjects returns an ObjectFunc that adds every object to objects
+func collectObjects(objects map[string]*api.ObjectDetail) ObjectFunc {
+	return func(key string, obj *api.ObjectDetail, cursor string) error {
+		objects[key] = obj
+		return nil
+	}
+}
+
+// errPageFull stops an iteration once its page holds Limit results
+var errPageFull = errors.New("page full")
+
+// pager keeps track of the results added to a page. A nil pager never limits results.
+type pager struct {
+	limit int64
+	after string
+	last  string
+	count int64
+	next  string
+}
+
+func newPager(page Page) (*pager, error) {
+	if page.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	after, err := base64.RawURLEncoding.DecodeString(page.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err.Error())
+	}
+	return &pager{
+		limit: page.Limit,
+		after: string(after),
+	}, nil
+}
+
+// seek returns the position an iterator over prefix has to seek to in order to skip the positions of previous pages.
+// ok is false if every position with the prefix belongs to a previous page.
+func (p *pager) seek(prefix []byte) (position []byte, ok bool) {
+	if p == nil || p.after == "" || p.after < string(prefix) {
+		return prefix, true
+	}
+	if !strings.HasPrefix(p.after, string(prefix)) {
+		return nil, false
+	}
+	return append([]byte(p.after), 0), true
+}
+
+// skip returns true if the position belongs to a previous page
+func (p *pager) skip(position string) bool {
+	return p != nil && p.after != "" && position <= p.after
+}
+
+// add records a result at position. It returns errPageFull(and sets the next cursor) if the page already holds Limit results.
+func (p *pager) add(position string) error {
+	if p == nil {
+		return nil
+	}
+	if p.limit > 0 && p.count >= p.limit {
+		p.next = base64.RawURLEncoding.EncodeToString([]byte(p.last))
+		return errPageFull
+	}
+	p.count++
+	p.last = position
+	return nil
+}
+
+// emit returns a function that passes the objects added to the page to fn along with their cursor
+func (p *pager) emit(fn ObjectFunc) func(key string, obj *api.ObjectDetail) error {
+	return func(key string, obj *api.ObjectDetail) error {
+		return fn(key, obj, base64.RawURLEncoding.EncodeToString([]byte(p.last)))
+	}
+}
+
+// done returns the cursor of the next page(empty if there are no more results) & the error that ended the iteration- if it wasn't errPageFull.
+func (p *pager) done(err error) (string, error) {
+	if err != nil && err != errPageFull {
+		return "", err
+	}
+	if p == nil {
+		return "", nil
+	}
+	return p.next, nil
+}
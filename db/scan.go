@@ -2,123 +2,206 @@ package db
 
 import (
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
 	"github.com/dgraph-io/badger/v2"
-	"github.com/gogo/protobuf/proto"
 	geo "github.com/paulmach/go.geo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
 	"regexp"
+	"sort"
+	"strings"
 )
 
-func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
+const nearbyStartRadius float64 = 1000
+
+// nearbyMaxRadius is half of the earths circumference- a bound with this radius covers the entire globe
+var nearbyMaxRadius = math.Pi * geo.EarthRadius
+
+func ScanBound(db *badger.DB, bound *api.Bound, keys []string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	next, err := ScanBoundFunc(db, bound, keys, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
+}
+
+// ScanBoundFunc calls fn with every object that ScanBound returns as soon as it is found
+func ScanBoundFunc(db *badger.DB, bound *api.Bound, keys []string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
+	match := func(key string, obj *api.ObjectDetail) bool {
+		return helpers.BoundContains(bound, obj.Object) && helpers.MetadataMatches(filter, obj.Object.Metadata)
+	}
+	emit := p.emit(fn)
 	if len(keys) > 0 {
-		for _, key := range keys {
-			item, err := txn.Get([]byte(key))
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
-			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
-				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-					objects[string(item.Key())] = obj
-				}
-			}
-		}
-	} else {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer iter.Close()
-		for iter.Rewind(); iter.Valid(); iter.Next() {
-			item := iter.Item()
-			if item.UserMeta() != 1 {
-				continue
-			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		return p.done(getKeys(txn, keys, p, func(key string, obj *api.ObjectDetail) error {
+			if !match(key, obj) {
+				return nil
 			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "(all) %s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
-				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-					objects[string(item.Key())] = obj
-				}
+			if err := p.add(key); err != nil {
+				return err
 			}
-		}
+			return emit(key, obj)
+		}))
+	}
+	return p.done(scanGeohashIndex(txn, boundCandidates(txn, bound), p, match, emit))
+}
+
+func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	next, err := ScanRegexBoundFunc(db, bound, rgex, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
 	}
-	return objects, nil
+	return objects, next, nil
 }
 
-func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
+// ScanRegexBoundFunc calls fn with every object that ScanRegexBound returns as soon as it is found
+func ScanRegexBoundFunc(db *badger.DB, bound *api.Bound, rgex string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	reg, err := regexp.Compile(rgex)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
+	return p.done(scanGeohashIndex(txn, boundCandidates(txn, bound), p, func(key string, obj *api.ObjectDetail) bool {
+		return reg.MatchString(key) && helpers.BoundContains(bound, obj.Object) && helpers.MetadataMatches(filter, obj.Object.Metadata)
+	}, p.emit(fn)))
+}
+
+func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
 	objects := map[string]*api.ObjectDetail{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	defer iter.Close()
-	for iter.Rewind(); iter.Valid(); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		match, err := regexp.MatchString(rgex, string(item.Key()))
+	next, err := ScanPrefixBoundFunc(db, bound, prefix, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
+}
+
+// ScanPrefixBoundFunc calls fn with every object that ScanPrefixBound returns as soon as it is found
+func ScanPrefixBoundFunc(db *badger.DB, bound *api.Bound, prefix string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	return p.done(scanGeohashIndex(txn, boundCandidates(txn, bound), p, func(key string, obj *api.ObjectDetail) bool {
+		return strings.HasPrefix(key, prefix) && helpers.BoundContains(bound, obj.Object) && helpers.MetadataMatches(filter, obj.Object.Metadata)
+	}, p.emit(fn)))
+}
+
+func Nearby(db *badger.DB, point *api.Point, k int, maxDistance float64, prefix, rgex string, filter *api.MetadataFilter) ([]*api.NearbyObject, error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	var reg *regexp.Regexp
+	if rgex != "" {
+		r, err := regexp.Compile(rgex)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
 		}
-		if match {
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		reg = r
+	}
+	center := geo.NewPointFromLatLng(point.Lat, point.Lon)
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	radius := nearbyStartRadius
+	for {
+		if maxDistance > 0 && radius > maxDistance {
+			radius = maxDistance
+		}
+		var objects []*api.NearbyObject
+		if err := scanGeohashIndex(txn, geo.NewGeoBoundAroundPoint(center, radius), nil, func(key string, obj *api.ObjectDetail) bool {
+			if prefix != "" && !strings.HasPrefix(key, prefix) {
+				return false
 			}
-			var obj = &api.ObjectDetail{}
-			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+			if reg != nil && !reg.MatchString(key) {
+				return false
 			}
-			if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-				objects[string(item.Key())] = obj
+			return helpers.MetadataMatches(filter, obj.Object.Metadata)
+		}, func(key string, obj *api.ObjectDetail) error {
+			dist := center.GeoDistanceFrom(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon), true)
+			if dist <= radius {
+				objects = append(objects, &api.NearbyObject{
+					Object:   obj,
+					Distance: dist,
+				})
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		//objects outside of the radius are always further away than the ones inside of it, so the search is complete once k objects are found
+		if len(objects) >= k || radius >= nearbyMaxRadius || (maxDistance > 0 && radius >= maxDistance) {
+			sort.Slice(objects, func(i, j int) bool {
+				return objects[i].Distance < objects[j].Distance
+			})
+			if len(objects) > k {
+				objects = objects[:k]
 			}
+			return objects, nil
 		}
+		radius *= 4
 	}
-	return objects, nil
 }
 
-func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
+func ScanPolygon(db *badger.DB, polygon *api.Polygon, keys []string, filter *api.MetadataFilter, page Page) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	next, err := ScanPolygonFunc(db, polygon, keys, filter, page, collectObjects(objects))
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
+}
+
+// ScanPolygonFunc calls fn with every object that ScanPolygon returns as soon as it is found
+func ScanPolygonFunc(db *badger.DB, polygon *api.Polygon, keys []string, filter *api.MetadataFilter, page Page, fn ObjectFunc) (string, error) {
+	if err := helpers.ValidatePolygon(polygon); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateFilter(filter); err != nil {
+		return "", err
+	}
+	p, err := newPager(page)
+	if err != nil {
+		return "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer iter.Close()
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		res, err := item.ValueCopy(nil)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-		}
-		var obj = &api.ObjectDetail{}
-		if err := proto.Unmarshal(res, obj); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
-		}
-		if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-			objects[string(item.Key())] = obj
-		}
+	match := func(key string, obj *api.ObjectDetail) bool {
+		return helpers.PolygonContains(polygon, obj.Object.Point) && helpers.MetadataMatches(filter, obj.Object.Metadata)
+	}
+	emit := p.emit(fn)
+	if len(keys) > 0 {
+		return p.done(getKeys(txn, keys, p, func(key string, obj *api.ObjectDetail) error {
+			if !match(key, obj) {
+				return nil
+			}
+			if err := p.add(key); err != nil {
+				return err
+			}
+			return emit(key, obj)
+		}))
 	}
-	return objects, nil
+	return p.done(scanGeohashIndex(txn, helpers.PolygonBound(polygon), p, match, emit))
 }
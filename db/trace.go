@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// ReplayTrace sets the trace points one after another as updates of the object(its point & updated_unix are overwritten) & calls fn with every update.
+// If speed is 0, the points are set immediately with their recorded timestamps. Otherwise each point is set once the time between it & the first point
+// divided by speed has passed- with the current time- so streams, trackers & geofences fire as they would live. Points without a timestamp are set without waiting.
+// The replay stops once the context is cancelled.
+func ReplayTrace(ctx context.Context, db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object, points []helpers.TracePoint, speed float64, fn func(detail *api.ObjectDetail, point helpers.TracePoint) error) error {
+	var (
+		start = time.Now()
+		first time.Time
+	)
+	for _, point := range points {
+		update := &api.Object{
+			Key:         obj.Key,
+			Point:       point.Point,
+			Radius:      obj.Radius,
+			Tracking:    obj.Tracking,
+			Metadata:    obj.Metadata,
+			GetAddress:  obj.GetAddress,
+			GetTimezone: obj.GetTimezone,
+			ExpiresUnix: obj.ExpiresUnix,
+		}
+		if speed > 0 && !point.Time.IsZero() {
+			if first.IsZero() {
+				first = point.Time
+			}
+			if wait := time.Duration(float64(point.Time.Sub(first))/speed) - time.Since(start); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return status.Error(codes.Canceled, "trace replay cancelled")
+				}
+			}
+		} else if !point.Time.IsZero() {
+			update.UpdatedUnix = point.Time.Unix()
+		}
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "trace replay cancelled")
+		}
+		detail, err := Set(db, maps, hub, update)
+		if err != nil {
+			return err
+		}
+		if err := fn(detail, point); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const trackerPrefix = "geodb_tracker_"
+
+// trackerTargetPrefix is the prefix of all reverse index entries of objects tracking the target. The targets length keeps targets that share a prefix apart.
+func trackerTargetPrefix(target string) []byte {
+	return []byte(fmt.Sprintf("%s%d_%s_", trackerPrefix, len(target), target))
+}
+
+func trackerKey(target, tracker string) []byte {
+	return append(trackerTargetPrefix(target), []byte(tracker)...)
+}
+
+// errTrackerMoved is returned by setTracker if the tracking object changed after its directions were computed
+var errTrackerMoved = errors.New("tracking object changed")
+
+// newTrackerEvent computes the relation of the tracking object(val) to its target
+func newTrackerEvent(maps *maps.Client, val *api.Object, tracker *api.ObjectTracker, target *api.Object) (*api.TrackerEvent, error) {
+	directions, err := trackerDirections(maps, val, tracker, target)
+	if err != nil {
+		return nil, err
+	}
+	trackerEvent := trackerDistance(val, target)
+	trackerEvent.Direction = directions
+	return trackerEvent, nil
+}
+
+// trackerDistance computes the distance of the tracking object(val) to its target without its directions
+func trackerDistance(val *api.Object, target *api.Object) *api.TrackerEvent {
+	point1 := geo.NewPointFromLatLng(val.Point.Lat, val.Point.Lon)
+	point2 := geo.NewPointFromLatLng(target.Point.Lat, target.Point.Lon)
+	dist := point1.GeoDistanceFrom(point2, true)
+	return &api.TrackerEvent{
+		Object:        target,
+		Distance:      dist,
+		Inside:        dist <= float64(val.Radius+target.Radius),
+		TimestampUnix: val.UpdatedUnix,
+	}
+}
+
+// trackerDirections requests the directions from the tracking object(val) to its target from google maps. It returns nil if maps isn't configured.
+func trackerDirections(maps *maps.Client, val *api.Object, tracker *api.ObjectTracker, target *api.Object) (*api.Directions, error) {
+	if maps == nil || val.Tracking == nil {
+		return nil, nil
+	}
+	directions, eta, dist, err := maps.TravelDetail(context.Background(), val.Point, target.Point, helpers.ToTravelMode(val.GetTracking().GetTravelMode()))
+	if err != nil {
+		return nil, err
+	}
+	detail := &api.Directions{}
+	if tracker.TrackDirections {
+		detail.HtmlDirections = directions
+	}
+	if tracker.TrackEta {
+		detail.Eta = int64(eta)
+	}
+	if tracker.TrackDistance {
+		detail.TravelDist = int64(dist)
+	}
+	return detail, nil
+}
+
+// setTrackerTransition compares the tracker event against the event of the same target in the objects previous detail & sets its transition
+func setTrackerTransition(event *api.TrackerEvent, previous *api.ObjectDetail) {
+	var last *api.TrackerEvent
+	if previous != nil {
+		for _, e := range previous.TrackerEvents {
+			if e.Object != nil && e.Object.Key == event.Object.Key {
+				last = e
+				break
+			}
+		}
+	}
+	wasInside := last != nil && last.Inside
+	switch {
+	case event.Inside && !wasInside:
+		event.Transition = api.Transition_Enter
+		event.TransitionUnix = event.TimestampUnix
+	case !event.Inside && wasInside:
+		event.Transition = api.Transition_Exit
+		event.TransitionUnix = event.TimestampUnix
+	case event.Inside:
+		event.Transition = api.Transition_Inside
+		event.TransitionUnix = last.TransitionUnix
+	default:
+		event.Transition = api.Transition_Outside
+		if last != nil {
+			event.TransitionUnix = last.TransitionUnix
+		}
+	}
+}
+
+// setTrackerIndex writes a reverse index entry for every target the object tracks & removes the entries of targets it no longer tracks
+func setTrackerIndex(txn *badger.Txn, obj *api.Object, previous *api.ObjectDetail) error {
+	targets := map[string]struct{}{}
+	for _, t := range obj.GetTracking().GetTrackers() {
+		targets[t.TargetObjectKey] = struct{}{}
+		if err := txn.SetEntry(&badger.Entry{
+			Key:       trackerKey(t.TargetObjectKey, obj.Key),
+			UserMeta:  trackerMeta,
+			ExpiresAt: uint64(obj.ExpiresUnix),
+		}); err != nil {
+			return err
+		}
+	}
+	if previous == nil {
+		return nil
+	}
+	for _, t := range previous.GetObject().GetTracking().GetTrackers() {
+		if _, ok := targets[t.TargetObjectKey]; !ok {
+			if err := txn.Delete(trackerKey(t.TargetObjectKey, obj.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteTrackerIndex removes the reverse index entries of the object stored under key
+func deleteTrackerIndex(txn *badger.Txn, key string) error {
+	obj, err := getObject(txn, key)
+	if err != nil || obj == nil {
+		return err
+	}
+	for _, t := range obj.GetObject().GetTracking().GetTrackers() {
+		if err := txn.Delete(trackerKey(t.TargetObjectKey, key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTrackers returns the keys of all objects that track the target
+func getTrackers(db *badger.DB, target string) ([]string, error) {
+	var trackers []string
+	err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		prefix := trackerTargetPrefix(target)
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != trackerMeta {
+				continue
+			}
+			trackers = append(trackers, string(item.Key()[len(prefix):]))
+		}
+		return nil
+	})
+	return trackers, err
+}
+
+// updateTrackers recomputes the tracker events of every object that tracks the target after the target moved & publishes the updated object details.
+// If target is nil, the target was deleted or expired- its tracker events become Exit(or Outside) events at its last known location.
+func updateTrackers(db *badger.DB, maps *maps.Client, hub *stream.Hub, key string, target *api.Object) {
+	trackers, err := getTrackers(db, key)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, trackingKey := range trackers {
+		if trackingKey == key {
+			continue
+		}
+		event, err := updateTracker(db, maps, trackingKey, key, target)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		if event != nil {
+			hub.PublishObjectEvent(event)
+		}
+	}
+}
+
+// updateTracker writes the tracking objects updated tracker events & returns the resulting Set event.
+// The directions are requested from google maps before the write transaction is opened. If the tracking object changes in between, they are requested
+// again for its new location.
+func updateTracker(db *badger.DB, maps *maps.Client, trackingKey, key string, target *api.Object) (*api.ObjectEvent, error) {
+	for {
+		var detail *api.ObjectDetail
+		if err := db.View(func(txn *badger.Txn) error {
+			var err error
+			detail, err = getObject(txn, trackingKey)
+			return err
+		}); err != nil || detail == nil {
+			return nil, err
+		}
+		tracker := getTracker(detail, key)
+		if tracker == nil {
+			return nil, nil
+		}
+		var directions *api.Directions
+		if target != nil {
+			var err error
+			directions, err = trackerDirections(maps, detail.Object, tracker, target)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var event *api.ObjectEvent
+		err := retryConflicts(func() error {
+			var err error
+			event, err = setTracker(db, trackingKey, key, target, detail.Object, directions)
+			return err
+		})
+		if err != errTrackerMoved {
+			return event, err
+		}
+	}
+}
+
+// getTracker returns the tracker of the object(detail) that tracks the target(key), or nil if it doesn't track it
+func getTracker(detail *api.ObjectDetail, key string) *api.ObjectTracker {
+	for _, t := range detail.GetObject().GetTracking().GetTrackers() {
+		if t.TargetObjectKey == key {
+			return t
+		}
+	}
+	return nil
+}
+
+// setTracker replaces the tracker event of the target(key) in the tracking objects detail with one that has the directions in the transaction.
+// errTrackerMoved is returned if the tracking object no longer matches the object(val) the directions were computed for.
+func setTracker(db *badger.DB, trackingKey, key string, target *api.Object, val *api.Object, directions *api.Directions) (*api.ObjectEvent, error) {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	detail, err := getObject(txn, trackingKey)
+	if err != nil || detail == nil {
+		return nil, err
+	}
+	if !proto.Equal(detail.Object, val) {
+		return nil, errTrackerMoved
+	}
+	var (
+		events []*api.TrackerEvent
+		last   *api.TrackerEvent
+	)
+	for _, e := range detail.TrackerEvents {
+		if e.Object == nil || e.Object.Key != key {
+			events = append(events, e)
+		} else {
+			last = e
+		}
+	}
+	switch {
+	case target != nil:
+		event := trackerDistance(detail.Object, target)
+		event.Direction = directions
+		event.TimestampUnix = target.UpdatedUnix
+		setTrackerTransition(event, detail)
+		events = append(events, event)
+	case last != nil:
+		// the target is gone- the tracking object is no longer inside of it
+		event := &api.TrackerEvent{
+			Object:        last.Object,
+			Distance:      last.Distance,
+			TimestampUnix: time.Now().Unix(),
+		}
+		setTrackerTransition(event, detail)
+		events = append(events, event)
+	default:
+		return nil, nil
+	}
+	detail.TrackerEvents = events
+	bits, err := proto.Marshal(detail)
+	if err != nil {
+		return nil, err
+	}
+	if err := txn.SetEntry(&badger.Entry{
+		Key:       []byte(trackingKey),
+		Value:     bits,
+		UserMeta:  objectMeta,
+		ExpiresAt: uint64(detail.Object.ExpiresUnix),
+	}); err != nil {
+		return nil, err
+	}
+	event := &api.ObjectEvent{
+		Type:          api.EventType_Set,
+		Object:        detail,
+		TimestampUnix: time.Now().Unix(),
+	}
+	if err := commitChangeLog(db, txn.SetEntry, func() error {
+		return commit(txn)
+	}, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,269 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+)
+
+var (
+	marshaler   = jsonpb.Marshaler{}
+	unmarshaler = jsonpb.Unmarshaler{}
+)
+
+type unaryFunc func(ctx context.Context, req proto.Message) (proto.Message, error)
+
+type streamFunc func(req proto.Message, stream *httpStream) error
+
+// unaryHandler decodes the json request body into a new request message, calls the rpc & encodes its response as json
+func unaryHandler(newRequest func() proto.Message, call unaryFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := newRequest()
+		if err := decode(c, req); err != nil {
+			return err
+		}
+		return respond(c, req, call)
+	}
+}
+
+// batchHandler is a unaryHandler for batch rpcs- the request isn't validated since the rpc validates & reports errors per object
+func batchHandler(newRequest func() proto.Message, call unaryFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := newRequest()
+		if err := unmarshaler.Unmarshal(c.Request().Body, req); err != nil && err != io.EOF {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return respond(c, req, call)
+	}
+}
+
+// clientStreamHandler calls the client streaming rpc with a stream that receives the json request messages of the request body(one after another, i.e. newline delimited)
+// & encodes the response as json
+func clientStreamHandler(call func(stream *httpStream) error) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		stream := &httpStream{
+			c:           c,
+			contentType: echo.MIMEApplicationJSONCharsetUTF8,
+			dec:         json.NewDecoder(c.Request().Body),
+		}
+		if err := call(stream); err != nil {
+			return httpError(err)
+		}
+		return nil
+	}
+}
+
+// respond calls the rpc & encodes its response as json
+func respond(c echo.Context, req proto.Message, call unaryFunc) error {
+	resp, err := call(c.Request().Context(), req)
+	if err != nil {
+		return httpError(err)
+	}
+	str, err := marshaler.MarshalToString(resp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, []byte(str))
+}
+
+// streamHandler decodes the json request body into a new request message & calls the server streaming rpc. Every streamed message is written as a line of json.
+// The status is only committed once the rpc sends its header or first message- an rpc that fails before then responds with the http error of its status,
+// an rpc that fails afterwards ends the stream with an error line(see httpStream.sendError).
+func streamHandler(newRequest func() proto.Message, call streamFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := newRequest()
+		if err := decode(c, req); err != nil {
+			return err
+		}
+		stream := &httpStream{
+			c:           c,
+			contentType: "application/x-ndjson",
+		}
+		return stream.finish(call(req, stream))
+	}
+}
+
+func decode(c echo.Context, req proto.Message) error {
+	if err := unmarshaler.Unmarshal(c.Request().Body, req); err != nil && err != io.EOF {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if v, ok := req.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+	return nil
+}
+
+// httpStream adapts an http response to the grpc.ServerStream interface
+type httpStream struct {
+	c echo.Context
+	// contentType is the content type of the response
+	contentType string
+	// started is true once the status has been written
+	started bool
+	// sse frames messages as server-sent events instead of lines of json
+	sse bool
+	// dec decodes the request messages of client streams
+	dec *json.Decoder
+}
+
+func (s *httpStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+// SendHeader commits the status of the response- streaming rpcs send the header once the stream is established so that clients see it before the first message
+func (s *httpStream) SendHeader(metadata.MD) error {
+	s.start()
+	return nil
+}
+
+// start writes the headers & the status of the response unless they have been written already
+func (s *httpStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.c.Response().Header().Set(echo.HeaderContentType, s.contentType)
+	if s.sse {
+		s.c.Response().Header().Set("Cache-Control", "no-cache")
+		s.c.Response().Header().Set("Connection", "keep-alive")
+	}
+	s.c.Response().WriteHeader(http.StatusOK)
+	s.c.Response().Flush()
+}
+
+// finish ends the response with the result of the rpc. If the status hasn't been written, the rpcs error is returned as an http error- otherwise it's sent on the stream.
+func (s *httpStream) finish(err error) error {
+	if err == nil {
+		s.start()
+		return nil
+	}
+	if !s.started {
+		return httpError(err)
+	}
+	log.Error(err.Error())
+	if err := s.sendError(err); err != nil {
+		log.Error(err.Error())
+	}
+	return nil
+}
+
+// sendError writes the status of the error- as an error event if the stream is a server-sent event stream, otherwise as a line of json with an error field
+func (s *httpStream) sendError(err error) error {
+	str, merr := marshaler.MarshalToString(status.Convert(err).Proto())
+	if merr != nil {
+		return merr
+	}
+	if s.sse {
+		str = fmt.Sprintf("event: error\ndata: %s\n\n", str)
+	} else {
+		str = fmt.Sprintf("{\"error\":%s}\n", str)
+	}
+	if _, err := s.c.Response().Write([]byte(str)); err != nil {
+		return err
+	}
+	s.c.Response().Flush()
+	return nil
+}
+
+func (s *httpStream) SetTrailer(metadata.MD) {}
+
+func (s *httpStream) Context() context.Context {
+	return s.c.Request().Context()
+}
+
+func (s *httpStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Error(codes.Internal, "stream message is not a protobuf message")
+	}
+	return s.sendEvent("", 0, msg)
+}
+
+// sendEvent writes the message to the response. If the stream is a server-sent event stream, the message is sent as a named event if event is not empty
+// & with an event id if id is not 0.
+func (s *httpStream) sendEvent(event string, id uint64, msg proto.Message) error {
+	str, err := marshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	s.start()
+	if s.sse {
+		str = fmt.Sprintf("data: %s\n\n", str)
+		if id != 0 {
+			str = fmt.Sprintf("id: %d\n%s", id, str)
+		}
+		if event != "" {
+			str = fmt.Sprintf("event: %s\n%s", event, str)
+		}
+	} else {
+		str += "\n"
+	}
+	if _, err := s.c.Response().Write([]byte(str)); err != nil {
+		return err
+	}
+	s.c.Response().Flush()
+	return nil
+}
+
+func (s *httpStream) RecvMsg(m interface{}) error {
+	if s.dec == nil {
+		return io.EOF
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Error(codes.Internal, "stream message is not a protobuf message")
+	}
+	if err := unmarshaler.UnmarshalNext(s.dec, msg); err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// httpError converts a grpc status error to an http error
+func httpError(err error) error {
+	st := status.Convert(err)
+	return echo.NewHTTPError(httpStatus(st.Code()), st.Message())
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
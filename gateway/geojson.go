@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// geojsonContentType is the media type of GeoJSON documents
+const geojsonContentType = "application/geo+json"
+
+// registerGeoJSON exposes GeoJSON export & import as plain GeoJSON documents instead of json encoded request & response messages.
+// GET /geojson returns a FeatureCollection of the objects selected by the keys, prefix, regex & lat/lon/radius/mode query parameters(tracker_events=true adds tracker events).
+// POST /geojson imports the FeatureCollection in the request body- the key_property, radius_property, default_radius & metadata_properties query parameters map feature properties to object fields.
+func registerGeoJSON(group *echo.Group, server api.GeoDBServer) {
+	group.GET("", func(c echo.Context) error {
+		req := &api.ExportGeoJSONRequest{
+			Keys:          c.QueryParams()["keys"],
+			Prefix:        c.QueryParam("prefix"),
+			Regex:         c.QueryParam("regex"),
+			TrackerEvents: c.QueryParam("tracker_events") == "true",
+		}
+		if c.QueryParam("lat") != "" || c.QueryParam("lon") != "" {
+			bound, err := queryBound(c)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			req.Bound = bound
+		}
+		resp, err := server.ExportGeoJSON(c.Request().Context(), req)
+		if err != nil {
+			return httpError(err)
+		}
+		return c.Blob(http.StatusOK, geojsonContentType, []byte(resp.FeatureCollection))
+	})
+	group.POST("", func(c echo.Context) error {
+		body, err := ioutil.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		req := &api.ImportGeoJSONRequest{
+			FeatureCollection:  string(body),
+			KeyProperty:        c.QueryParam("key_property"),
+			RadiusProperty:     c.QueryParam("radius_property"),
+			MetadataProperties: c.QueryParams()["metadata_properties"],
+		}
+		if param := c.QueryParam("default_radius"); param != "" {
+			radius, err := strconv.ParseInt(param, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid default_radius: "+err.Error())
+			}
+			req.DefaultRadius = radius
+		}
+		return respond(c, req, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return server.ImportGeoJSON(ctx, req.(*api.ImportGeoJSONRequest))
+		})
+	})
+}
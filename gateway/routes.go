@@ -0,0 +1,310 @@
+package gateway
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/auth"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+)
+
+// Register exposes every GeoDB rpc as json over http. Requests are POSTed to /api/{rpc name} with the json encoded request message as the body.
+// Client streams(SetStream & ImportCSV) accept the json encoded request messages one after another as the body.
+// Streams are additionally exposed as server-sent events at /sse/{rpc name}, objects as mapbox vector tiles at /tiles/{z}/{x}/{y}.mvt
+// & GeoJSON export/import as plain GeoJSON documents at /geojson.
+func Register(router *echo.Echo, server api.GeoDBServer) {
+	registerSSE(router.Group("/sse", auth.BrowserAuthMiddleware()), server)
+	registerTiles(router.Group("/tiles", auth.BasicAuthMiddleware()), server)
+	registerGeoJSON(router.Group("/geojson", auth.BasicAuthMiddleware()), server)
+	group := router.Group("/api", auth.BasicAuthMiddleware())
+	group.POST("/Ping", unaryHandler(func() proto.Message { return &api.PingRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Ping(ctx, req.(*api.PingRequest))
+	}))
+	group.POST("/Set", unaryHandler(func() proto.Message { return &api.SetRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Set(ctx, req.(*api.SetRequest))
+	}))
+	group.POST("/SetBatch", batchHandler(func() proto.Message { return &api.SetBatchRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.SetBatch(ctx, req.(*api.SetBatchRequest))
+	}))
+	group.POST("/SetStream", clientStreamHandler(func(stream *httpStream) error {
+		return server.SetStream(setStreamServer{stream})
+	}))
+	group.POST("/ImportCSV", clientStreamHandler(func(stream *httpStream) error {
+		return server.ImportCSV(importCSVServer{stream})
+	}))
+	group.POST("/ImportTrace", streamHandler(func() proto.Message { return &api.ImportTraceRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.ImportTrace(req.(*api.ImportTraceRequest), importTraceServer{stream})
+	}))
+	group.POST("/Get", unaryHandler(func() proto.Message { return &api.GetRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Get(ctx, req.(*api.GetRequest))
+	}))
+	group.POST("/GetRegex", unaryHandler(func() proto.Message { return &api.GetRegexRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetRegex(ctx, req.(*api.GetRegexRequest))
+	}))
+	group.POST("/GetPrefix", unaryHandler(func() proto.Message { return &api.GetPrefixRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetPrefix(ctx, req.(*api.GetPrefixRequest))
+	}))
+	group.POST("/GetStream", streamHandler(func() proto.Message { return &api.GetRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.GetStream(req.(*api.GetRequest), getStreamServer{stream})
+	}))
+	group.POST("/GetRegexStream", streamHandler(func() proto.Message { return &api.GetRegexRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.GetRegexStream(req.(*api.GetRegexRequest), getRegexStreamServer{stream})
+	}))
+	group.POST("/GetPrefixStream", streamHandler(func() proto.Message { return &api.GetPrefixRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.GetPrefixStream(req.(*api.GetPrefixRequest), getPrefixStreamServer{stream})
+	}))
+	group.POST("/GetKeys", unaryHandler(func() proto.Message { return &api.GetKeysRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetKeys(ctx, req.(*api.GetKeysRequest))
+	}))
+	group.POST("/GetRegexKeys", unaryHandler(func() proto.Message { return &api.GetRegexKeysRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetRegexKeys(ctx, req.(*api.GetRegexKeysRequest))
+	}))
+	group.POST("/GetPrefixKeys", unaryHandler(func() proto.Message { return &api.GetPrefixKeysRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetPrefixKeys(ctx, req.(*api.GetPrefixKeysRequest))
+	}))
+	group.POST("/Delete", unaryHandler(func() proto.Message { return &api.DeleteRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Delete(ctx, req.(*api.DeleteRequest))
+	}))
+	group.POST("/ScanBound", unaryHandler(func() proto.Message { return &api.ScanBoundRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ScanBound(ctx, req.(*api.ScanBoundRequest))
+	}))
+	group.POST("/ScanRegexBound", unaryHandler(func() proto.Message { return &api.ScanRegexBoundRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ScanRegexBound(ctx, req.(*api.ScanRegexBoundRequest))
+	}))
+	group.POST("/ScanPrefixBound", unaryHandler(func() proto.Message { return &api.ScanPrefixBoundRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ScanPrefixBound(ctx, req.(*api.ScanPrefixBoundRequest))
+	}))
+	group.POST("/ScanPolygon", unaryHandler(func() proto.Message { return &api.ScanPolygonRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ScanPolygon(ctx, req.(*api.ScanPolygonRequest))
+	}))
+	group.POST("/ScanBoundStream", streamHandler(func() proto.Message { return &api.ScanBoundRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.ScanBoundStream(req.(*api.ScanBoundRequest), scanBoundStreamServer{stream})
+	}))
+	group.POST("/ScanRegexBoundStream", streamHandler(func() proto.Message { return &api.ScanRegexBoundRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.ScanRegexBoundStream(req.(*api.ScanRegexBoundRequest), scanRegexBoundStreamServer{stream})
+	}))
+	group.POST("/ScanPrefixBoundStream", streamHandler(func() proto.Message { return &api.ScanPrefixBoundRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.ScanPrefixBoundStream(req.(*api.ScanPrefixBoundRequest), scanPrefixBoundStreamServer{stream})
+	}))
+	group.POST("/ScanPolygonStream", streamHandler(func() proto.Message { return &api.ScanPolygonRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.ScanPolygonStream(req.(*api.ScanPolygonRequest), scanPolygonStreamServer{stream})
+	}))
+	group.POST("/Nearby", unaryHandler(func() proto.Message { return &api.NearbyRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Nearby(ctx, req.(*api.NearbyRequest))
+	}))
+	group.POST("/Count", unaryHandler(func() proto.Message { return &api.CountRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Count(ctx, req.(*api.CountRequest))
+	}))
+	group.POST("/Heatmap", unaryHandler(func() proto.Message { return &api.HeatmapRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.Heatmap(ctx, req.(*api.HeatmapRequest))
+	}))
+	group.POST("/ExportGeoJSON", unaryHandler(func() proto.Message { return &api.ExportGeoJSONRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ExportGeoJSON(ctx, req.(*api.ExportGeoJSONRequest))
+	}))
+	group.POST("/ImportGeoJSON", unaryHandler(func() proto.Message { return &api.ImportGeoJSONRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ImportGeoJSON(ctx, req.(*api.ImportGeoJSONRequest))
+	}))
+	group.POST("/CreateMetadataIndex", unaryHandler(func() proto.Message { return &api.CreateMetadataIndexRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.CreateMetadataIndex(ctx, req.(*api.CreateMetadataIndexRequest))
+	}))
+	group.POST("/DeleteMetadataIndex", unaryHandler(func() proto.Message { return &api.DeleteMetadataIndexRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.DeleteMetadataIndex(ctx, req.(*api.DeleteMetadataIndexRequest))
+	}))
+	group.POST("/ListMetadataIndexes", unaryHandler(func() proto.Message { return &api.ListMetadataIndexesRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ListMetadataIndexes(ctx, req.(*api.ListMetadataIndexesRequest))
+	}))
+	group.POST("/CreateGeofence", unaryHandler(func() proto.Message { return &api.CreateGeofenceRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.CreateGeofence(ctx, req.(*api.CreateGeofenceRequest))
+	}))
+	group.POST("/DeleteGeofence", unaryHandler(func() proto.Message { return &api.DeleteGeofenceRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.DeleteGeofence(ctx, req.(*api.DeleteGeofenceRequest))
+	}))
+	group.POST("/ListGeofences", unaryHandler(func() proto.Message { return &api.ListGeofencesRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.ListGeofences(ctx, req.(*api.ListGeofencesRequest))
+	}))
+	group.POST("/GetGeofenceEvents", unaryHandler(func() proto.Message { return &api.GetGeofenceEventsRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetGeofenceEvents(ctx, req.(*api.GetGeofenceEventsRequest))
+	}))
+	group.POST("/GetTrajectory", unaryHandler(func() proto.Message { return &api.GetTrajectoryRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetTrajectory(ctx, req.(*api.GetTrajectoryRequest))
+	}))
+	group.POST("/GetPointAt", unaryHandler(func() proto.Message { return &api.GetPointAtRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetPointAt(ctx, req.(*api.GetPointAtRequest))
+	}))
+	group.POST("/GetPoint", unaryHandler(func() proto.Message { return &api.GetPointRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return server.GetPoint(ctx, req.(*api.GetPointRequest))
+	}))
+	group.POST("/Backup", streamHandler(func() proto.Message { return &api.BackupRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.Backup(req.(*api.BackupRequest), backupServer{stream})
+	}))
+	group.POST("/Stream", streamHandler(func() proto.Message { return &api.StreamRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.Stream(req.(*api.StreamRequest), streamServer{stream})
+	}))
+	group.POST("/StreamRegex", streamHandler(func() proto.Message { return &api.StreamRegexRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.StreamRegex(req.(*api.StreamRegexRequest), streamRegexServer{stream})
+	}))
+	group.POST("/StreamPrefix", streamHandler(func() proto.Message { return &api.StreamPrefixRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.StreamPrefix(req.(*api.StreamPrefixRequest), streamPrefixServer{stream})
+	}))
+	group.POST("/StreamBound", streamHandler(func() proto.Message { return &api.StreamBoundRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.StreamBound(req.(*api.StreamBoundRequest), streamBoundServer{stream})
+	}))
+	group.POST("/StreamPolygon", streamHandler(func() proto.Message { return &api.StreamPolygonRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.StreamPolygon(req.(*api.StreamPolygonRequest), streamPolygonServer{stream})
+	}))
+	group.POST("/StreamGeofence", streamHandler(func() proto.Message { return &api.StreamGeofenceRequest{} }, func(req proto.Message, stream *httpStream) error {
+		return server.StreamGeofence(req.(*api.StreamGeofenceRequest), streamGeofenceServer{stream})
+	}))
+}
+
+type setStreamServer struct {
+	*httpStream
+}
+
+func (s setStreamServer) SendAndClose(m *api.SetStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+func (s setStreamServer) Recv() (*api.SetRequest, error) {
+	var m = &api.SetRequest{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type importCSVServer struct {
+	*httpStream
+}
+
+func (s importCSVServer) SendAndClose(m *api.ImportCSVResponse) error {
+	return s.SendMsg(m)
+}
+
+func (s importCSVServer) Recv() (*api.ImportCSVRequest, error) {
+	var m = &api.ImportCSVRequest{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type importTraceServer struct {
+	*httpStream
+}
+
+func (s importTraceServer) Send(m *api.ImportTraceResponse) error {
+	return s.SendMsg(m)
+}
+
+type backupServer struct {
+	*httpStream
+}
+
+func (s backupServer) Send(m *api.BackupResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamServer struct {
+	*httpStream
+}
+
+func (s streamServer) Send(m *api.StreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamRegexServer struct {
+	*httpStream
+}
+
+func (s streamRegexServer) Send(m *api.StreamRegexResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamPrefixServer struct {
+	*httpStream
+}
+
+func (s streamPrefixServer) Send(m *api.StreamPrefixResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamBoundServer struct {
+	*httpStream
+}
+
+func (s streamBoundServer) Send(m *api.StreamBoundResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamPolygonServer struct {
+	*httpStream
+}
+
+func (s streamPolygonServer) Send(m *api.StreamPolygonResponse) error {
+	return s.SendMsg(m)
+}
+
+type streamGeofenceServer struct {
+	*httpStream
+}
+
+func (s streamGeofenceServer) Send(m *api.StreamGeofenceResponse) error {
+	return s.SendMsg(m)
+}
+
+type getStreamServer struct {
+	*httpStream
+}
+
+func (s getStreamServer) Send(m *api.GetStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type getRegexStreamServer struct {
+	*httpStream
+}
+
+func (s getRegexStreamServer) Send(m *api.GetRegexStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type getPrefixStreamServer struct {
+	*httpStream
+}
+
+func (s getPrefixStreamServer) Send(m *api.GetPrefixStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type scanBoundStreamServer struct {
+	*httpStream
+}
+
+func (s scanBoundStreamServer) Send(m *api.ScanBoundStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type scanRegexBoundStreamServer struct {
+	*httpStream
+}
+
+func (s scanRegexBoundStreamServer) Send(m *api.ScanRegexBoundStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type scanPrefixBoundStreamServer struct {
+	*httpStream
+}
+
+func (s scanPrefixBoundStreamServer) Send(m *api.ScanPrefixBoundStreamResponse) error {
+	return s.SendMsg(m)
+}
+
+type scanPolygonStreamServer struct {
+	*httpStream
+}
+
+func (s scanPolygonStreamServer) Send(m *api.ScanPolygonStreamResponse) error {
+	return s.SendMsg(m)
+}
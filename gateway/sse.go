@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/labstack/echo"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// registerSSE exposes the object & geofence streams as server-sent events for browsers. Filters are passed as query parameters & each event's data is a json encoded ObjectDetail(or GeofenceEvent).
+// Deleted & expired objects are sent as "delete" & "expired" events. Each event's id is its change log sequence so that reconnecting browsers resume where they left off.
+// Errors that occur once the stream is established are sent as an "error" event with the json encoded status.
+// Since EventSource can't set headers, the group is expected to use auth.BrowserAuthMiddleware.
+func registerSSE(group *echo.Group, server api.GeoDBServer) {
+	group.GET("/Stream", sseHandler(func(c echo.Context, stream *httpStream) error {
+		return server.Stream(&api.StreamRequest{
+			ClientId:       c.QueryParam("client_id"),
+			ResumeSequence: resumeSequence(c),
+			SinceUnix:      sinceUnix(c),
+			Keys:           c.QueryParams()["keys"],
+		}, sseStreamServer{stream})
+	}))
+	group.GET("/StreamPrefix", sseHandler(func(c echo.Context, stream *httpStream) error {
+		return server.StreamPrefix(&api.StreamPrefixRequest{
+			ClientId:       c.QueryParam("client_id"),
+			ResumeSequence: resumeSequence(c),
+			SinceUnix:      sinceUnix(c),
+			Prefix:         c.QueryParam("prefix"),
+		}, sseStreamPrefixServer{stream})
+	}))
+	group.GET("/StreamRegex", sseHandler(func(c echo.Context, stream *httpStream) error {
+		return server.StreamRegex(&api.StreamRegexRequest{
+			ClientId:       c.QueryParam("client_id"),
+			ResumeSequence: resumeSequence(c),
+			SinceUnix:      sinceUnix(c),
+			Regex:          c.QueryParam("regex"),
+		}, sseStreamRegexServer{stream})
+	}))
+	group.GET("/StreamBound", func(c echo.Context) error {
+		bound, err := queryBound(c)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return sseHandler(func(c echo.Context, stream *httpStream) error {
+			return server.StreamBound(&api.StreamBoundRequest{
+				ClientId:       c.QueryParam("client_id"),
+				ResumeSequence: resumeSequence(c),
+				SinceUnix:      sinceUnix(c),
+				Bound:          bound,
+				Keys:           c.QueryParams()["keys"],
+			}, sseStreamBoundServer{stream})
+		})(c)
+	})
+	group.GET("/StreamGeofence", sseHandler(func(c echo.Context, stream *httpStream) error {
+		return server.StreamGeofence(&api.StreamGeofenceRequest{
+			ClientId: c.QueryParam("client_id"),
+			Names:    c.QueryParams()["names"],
+		}, sseStreamGeofenceServer{stream})
+	}))
+}
+
+// resumeSequence returns the change log sequence to resume the stream from. Browsers send the id of the last received event in the Last-Event-ID header when they reconnect.
+func resumeSequence(c echo.Context) uint64 {
+	id := c.Request().Header.Get("Last-Event-ID")
+	if id == "" {
+		id = c.QueryParam("resume_sequence")
+	}
+	sequence, _ := strconv.ParseUint(id, 10, 64)
+	return sequence
+}
+
+func sinceUnix(c echo.Context) int64 {
+	since, _ := strconv.ParseInt(c.QueryParam("since_unix"), 10, 64)
+	return since
+}
+
+// queryBound parses the lat, lon, radius & mode(optional) query parameters
+func queryBound(c echo.Context) (*api.Bound, error) {
+	var values [3]float64
+	for i, param := range []string{"lat", "lon", "radius"} {
+		val, err := strconv.ParseFloat(c.QueryParam(param), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", param, err.Error())
+		}
+		values[i] = val
+	}
+	return &api.Bound{
+		Center: &api.Point{
+			Lat: values[0],
+			Lon: values[1],
+		},
+		Radius: values[2],
+		Mode:   api.BoundMode(api.BoundMode_value[c.QueryParam("mode")]),
+	}, nil
+}
+
+// sseHandler calls the streaming rpc with a server-sent event stream. Like streamHandler, the status is only committed once the stream is established-
+// so that EventSource gives up on requests that fail(ex: an invalid regex) instead of reconnecting- & later errors are sent as an error event.
+func sseHandler(call func(c echo.Context, stream *httpStream) error) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		stream := &httpStream{
+			c:           c,
+			contentType: "text/event-stream",
+			sse:         true,
+		}
+		return stream.finish(call(c, stream))
+	}
+}
+
+// sseEventName returns the name of the server-sent event for the event type. Set events are unnamed so that they are delivered to EventSource.onmessage.
+func sseEventName(eventType api.EventType) string {
+	if eventType == api.EventType_Set {
+		return ""
+	}
+	return strings.ToLower(eventType.String())
+}
+
+type sseStreamServer struct {
+	*httpStream
+}
+
+func (s sseStreamServer) Send(m *api.StreamResponse) error {
+	return s.sendEvent(sseEventName(m.Type), m.Sequence, m.Object)
+}
+
+type sseStreamPrefixServer struct {
+	*httpStream
+}
+
+func (s sseStreamPrefixServer) Send(m *api.StreamPrefixResponse) error {
+	return s.sendEvent(sseEventName(m.Type), m.Sequence, m.Object)
+}
+
+type sseStreamRegexServer struct {
+	*httpStream
+}
+
+func (s sseStreamRegexServer) Send(m *api.StreamRegexResponse) error {
+	return s.sendEvent(sseEventName(m.Type), m.Sequence, m.Object)
+}
+
+type sseStreamBoundServer struct {
+	*httpStream
+}
+
+func (s sseStreamBoundServer) Send(m *api.StreamBoundResponse) error {
+	return s.sendEvent(sseEventName(m.Type), m.Sequence, m.Object)
+}
+
+type sseStreamGeofenceServer struct {
+	*httpStream
+}
+
+func (s sseStreamGeofenceServer) Send(m *api.StreamGeofenceResponse) error {
+	return s.SendMsg(m.Event)
+}
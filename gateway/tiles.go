@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/labstack/echo"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// tileLayer is the name of the vector tile layer that holds the objects
+	tileLayer = "objects"
+	// tileContentType is the media type of mapbox vector tiles
+	tileContentType = "application/vnd.mapbox-vector-tile"
+)
+
+// registerTiles exposes the objects as mapbox vector tiles at /tiles/{z}/{x}/{y}.mvt. Each object is a point feature of the "objects" layer with its key as the "key" property.
+// The prefix query parameter only includes objects that have keys with the prefix & each fields query parameter adds the metadata field of the same name as a feature property.
+func registerTiles(group *echo.Group, server api.GeoDBServer) {
+	group.GET("/:z/:x/:y", func(c echo.Context) error {
+		z, x, y, err := tileIndex(c)
+		if err != nil {
+			return err
+		}
+		resp, err := server.ScanPrefixBound(c.Request().Context(), &api.ScanPrefixBoundRequest{
+			Bound:  helpers.TileBound(z, x, y),
+			Prefix: c.QueryParam("prefix"),
+		})
+		if err != nil {
+			return httpError(err)
+		}
+		fields := c.QueryParams()["fields"]
+		var features []helpers.TileFeature
+		for key, obj := range resp.Objects {
+			px, py, ok := helpers.TilePixel(obj.Object.Point, z, x, y)
+			if !ok {
+				continue
+			}
+			properties := map[string]string{"key": key}
+			for _, field := range fields {
+				if value, ok := obj.Object.Metadata[field]; ok {
+					properties[field] = value
+				}
+			}
+			features = append(features, helpers.TileFeature{
+				X:          px,
+				Y:          py,
+				Properties: properties,
+			})
+		}
+		sort.Slice(features, func(i, j int) bool {
+			return features[i].Properties["key"] < features[j].Properties["key"]
+		})
+		return c.Blob(http.StatusOK, tileContentType, helpers.EncodeVectorTile(tileLayer, features))
+	})
+}
+
+// tileIndex parses the z/x/y.mvt path parameters of a tile request
+func tileIndex(c echo.Context) (z, x, y uint64, err error) {
+	if !strings.HasSuffix(c.Param("y"), ".mvt") {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusNotFound, "tiles must be requested as {z}/{x}/{y}.mvt")
+	}
+	z, err = strconv.ParseUint(c.Param("z"), 10, 64)
+	if err != nil || z > helpers.MaxTileZoom {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "invalid zoom level")
+	}
+	x, err = strconv.ParseUint(c.Param("x"), 10, 64)
+	if err != nil || x >= 1<<z {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "invalid tile x index")
+	}
+	y, err = strconv.ParseUint(strings.TrimSuffix(c.Param("y"), ".mvt"), 10, 64)
+	if err != nil || y >= 1<<z {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "invalid tile y index")
+	}
+	return z, x, y, nil
+}
@@ -25,6 +25,137 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+//BoundMode determines how objects are matched against a Bound
+type BoundMode int32
+
+const (
+	BoundMode_PointInside      BoundMode = 0
+	BoundMode_CirclesIntersect BoundMode = 1
+)
+
+var BoundMode_name = map[int32]string{
+	0: "PointInside",
+	1: "CirclesIntersect",
+}
+
+var BoundMode_value = map[string]int32{
+	"PointInside":      0,
+	"CirclesIntersect": 1,
+}
+
+func (x BoundMode) String() string {
+	return proto.EnumName(BoundMode_name, int32(x))
+}
+
+func (BoundMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+//Transition describes an objects relation to an area(a geofence or a tracked object) and how it changed since the objects last update
+type Transition int32
+
+const (
+	Transition_Outside Transition = 0
+	Transition_Inside  Transition = 1
+	Transition_Enter   Transition = 2
+	Transition_Exit    Transition = 3
+)
+
+var Transition_name = map[int32]string{
+	0: "Outside",
+	1: "Inside",
+	2: "Enter",
+	3: "Exit",
+}
+
+var Transition_value = map[string]int32{
+	"Outside": 0,
+	"Inside":  1,
+	"Enter":   2,
+	"Exit":    3,
+}
+
+func (x Transition) String() string {
+	return proto.EnumName(Transition_name, int32(x))
+}
+
+func (Transition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+//MetadataOperator compares an objects metadata value with the values of a MetadataCondition
+type MetadataOperator int32
+
+const (
+	MetadataOperator_Equal              MetadataOperator = 0
+	MetadataOperator_In                 MetadataOperator = 1
+	MetadataOperator_Exists             MetadataOperator = 2
+	MetadataOperator_GreaterThan        MetadataOperator = 3
+	MetadataOperator_GreaterThanOrEqual MetadataOperator = 4
+	MetadataOperator_LessThan           MetadataOperator = 5
+	MetadataOperator_LessThanOrEqual    MetadataOperator = 6
+)
+
+var MetadataOperator_name = map[int32]string{
+	0: "Equal",
+	1: "In",
+	2: "Exists",
+	3: "GreaterThan",
+	4: "GreaterThanOrEqual",
+	5: "LessThan",
+	6: "LessThanOrEqual",
+}
+
+var MetadataOperator_value = map[string]int32{
+	"Equal":              0,
+	"In":                 1,
+	"Exists":             2,
+	"GreaterThan":        3,
+	"GreaterThanOrEqual": 4,
+	"LessThan":           5,
+	"LessThanOrEqual":    6,
+}
+
+func (x MetadataOperator) String() string {
+	return proto.EnumName(MetadataOperator_name, int32(x))
+}
+
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+//EventType describes the change that caused an object to be streamed
+type EventType int32
+
+const (
+	EventType_Set     EventType = 0
+	EventType_Delete  EventType = 1
+	EventType_Expired EventType = 2
+	EventType_Exit    EventType = 3
+)
+
+var EventType_name = map[int32]string{
+	0: "Set",
+	1: "Delete",
+	2: "Expired",
+	3: "Exit",
+}
+
+var EventType_value = map[string]int32{
+	"Set":     0,
+	"Delete":  1,
+	"Expired": 2,
+	"Exit":    3,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
 //TravelMode is used to generate directions based on the type of travel the object is utilizing. only necessary if using google maps
 type TravelMode int32
 
@@ -54,7 +185,33 @@ func (x TravelMode) String() string {
 }
 
 func (TravelMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+//TraceFormat is the format of a recorded trace
+type TraceFormat int32
+
+const (
+	TraceFormat_GPX TraceFormat = 0
+	TraceFormat_KML TraceFormat = 1
+)
+
+var TraceFormat_name = map[int32]string{
+	0: "GPX",
+	1: "KML",
+}
+
+var TraceFormat_value = map[string]int32{
+	"GPX": 0,
+	"KML": 1,
+}
+
+func (x TraceFormat) String() string {
+	return proto.EnumName(TraceFormat_name, int32(x))
+}
+
+func (TraceFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 //A Point is a simple X/Y or Lng/Lat 2d point. [X, Y] or [Lng, Lat]
@@ -105,12 +262,14 @@ func (m *Point) GetLon() float64 {
 	return 0
 }
 
+//A Bound is a circle with a center point & a radius in meters
 type Bound struct {
-	Center               *Point   `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
-	Radius               float64  `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Center               *Point    `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius               float64   `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	Mode                 BoundMode `protobuf:"varint,3,opt,name=mode,proto3,enum=api.BoundMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Bound) Reset()         { *m = Bound{} }
@@ -152,6 +311,102 @@ func (m *Bound) GetRadius() float64 {
 	return 0
 }
 
+func (m *Bound) GetMode() BoundMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BoundMode_PointInside
+}
+
+//A Polygon is an arbitrary closed shape. The first & last points do not need to be equal.
+//Edges are straight lines in lat/lon, so polygons may not cross the antimeridian(no edge may span more than 180 degrees of longitude)- split such an area into a polygon on either side of it.
+type Polygon struct {
+	Points               []*Point       `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Holes                []*PolygonHole `protobuf:"bytes,2,rep,name=holes,proto3" json:"holes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Polygon) Reset()         { *m = Polygon{} }
+func (m *Polygon) String() string { return proto.CompactTextString(m) }
+func (*Polygon) ProtoMessage()    {}
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *Polygon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Polygon.Unmarshal(m, b)
+}
+func (m *Polygon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Polygon.Marshal(b, m, deterministic)
+}
+func (m *Polygon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Polygon.Merge(m, src)
+}
+func (m *Polygon) XXX_Size() int {
+	return xxx_messageInfo_Polygon.Size(m)
+}
+func (m *Polygon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Polygon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Polygon proto.InternalMessageInfo
+
+func (m *Polygon) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *Polygon) GetHoles() []*PolygonHole {
+	if m != nil {
+		return m.Holes
+	}
+	return nil
+}
+
+//A PolygonHole is an inner ring of a Polygon
+type PolygonHole struct {
+	Points               []*Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolygonHole) Reset()         { *m = PolygonHole{} }
+func (m *PolygonHole) String() string { return proto.CompactTextString(m) }
+func (*PolygonHole) ProtoMessage()    {}
+func (*PolygonHole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *PolygonHole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolygonHole.Unmarshal(m, b)
+}
+func (m *PolygonHole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolygonHole.Marshal(b, m, deterministic)
+}
+func (m *PolygonHole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolygonHole.Merge(m, src)
+}
+func (m *PolygonHole) XXX_Size() int {
+	return xxx_messageInfo_PolygonHole.Size(m)
+}
+func (m *PolygonHole) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolygonHole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolygonHole proto.InternalMessageInfo
+
+func (m *PolygonHole) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
 //An Object represents anything that has a unique identifier, and a geolocation.
 type Object struct {
 	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -172,7 +427,7 @@ func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *Object) XXX_Unmarshal(b []byte) error {
@@ -269,7 +524,7 @@ func (m *ObjectTracking) Reset()         { *m = ObjectTracking{} }
 func (m *ObjectTracking) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracking) ProtoMessage()    {}
 func (*ObjectTracking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *ObjectTracking) XXX_Unmarshal(b []byte) error {
@@ -319,7 +574,7 @@ func (m *ObjectTracker) Reset()         { *m = ObjectTracker{} }
 func (m *ObjectTracker) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracker) ProtoMessage()    {}
 func (*ObjectTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *ObjectTracker) XXX_Unmarshal(b []byte) error {
@@ -382,7 +637,7 @@ func (m *Directions) Reset()         { *m = Directions{} }
 func (m *Directions) String() string { return proto.CompactTextString(m) }
 func (*Directions) ProtoMessage()    {}
 func (*Directions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *Directions) XXX_Unmarshal(b []byte) error {
@@ -441,7 +696,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -512,6 +767,8 @@ type TrackerEvent struct {
 	Inside               bool        `protobuf:"varint,3,opt,name=inside,proto3" json:"inside,omitempty"`
 	Direction            *Directions `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
 	TimestampUnix        int64       `protobuf:"varint,5,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Transition           Transition  `protobuf:"varint,6,opt,name=transition,proto3,enum=api.Transition" json:"transition,omitempty"`
+	TransitionUnix       int64       `protobuf:"varint,7,opt,name=transition_unix,json=transitionUnix,proto3" json:"transition_unix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -521,7 +778,7 @@ func (m *TrackerEvent) Reset()         { *m = TrackerEvent{} }
 func (m *TrackerEvent) String() string { return proto.CompactTextString(m) }
 func (*TrackerEvent) ProtoMessage()    {}
 func (*TrackerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
 func (m *TrackerEvent) XXX_Unmarshal(b []byte) error {
@@ -577,6 +834,20 @@ func (m *TrackerEvent) GetTimestampUnix() int64 {
 	return 0
 }
 
+func (m *TrackerEvent) GetTransition() Transition {
+	if m != nil {
+		return m.Transition
+	}
+	return Transition_Outside
+}
+
+func (m *TrackerEvent) GetTransitionUnix() int64 {
+	if m != nil {
+		return m.TransitionUnix
+	}
+	return 0
+}
+
 //ObjectDetail is an enhanced view of an Object containing a human readable address and the objects latest tracking information
 type ObjectDetail struct {
 	Object               *Object         `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
@@ -592,7 +863,7 @@ func (m *ObjectDetail) Reset()         { *m = ObjectDetail{} }
 func (m *ObjectDetail) String() string { return proto.CompactTextString(m) }
 func (*ObjectDetail) ProtoMessage()    {}
 func (*ObjectDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
 func (m *ObjectDetail) XXX_Unmarshal(b []byte) error {
@@ -641,1282 +912,4901 @@ func (m *ObjectDetail) GetTrackerEvents() []*TrackerEvent {
 	return nil
 }
 
-type StreamRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//An ObjectEvent is a change to an object that is published to streams
+type ObjectEvent struct {
+	Type                 EventType     `protobuf:"varint,1,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	Object               *ObjectDetail `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Previous             *Object       `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
-func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamRequest) ProtoMessage()    {}
-func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+func (m *ObjectEvent) Reset()         { *m = ObjectEvent{} }
+func (m *ObjectEvent) String() string { return proto.CompactTextString(m) }
+func (*ObjectEvent) ProtoMessage()    {}
+func (*ObjectEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
-func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
+func (m *ObjectEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectEvent.Unmarshal(m, b)
 }
-func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
+func (m *ObjectEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectEvent.Marshal(b, m, deterministic)
 }
-func (m *StreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRequest.Merge(m, src)
+func (m *ObjectEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectEvent.Merge(m, src)
 }
-func (m *StreamRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamRequest.Size(m)
+func (m *ObjectEvent) XXX_Size() int {
+	return xxx_messageInfo_ObjectEvent.Size(m)
 }
-func (m *StreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+func (m *ObjectEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_ObjectEvent proto.InternalMessageInfo
 
-func (m *StreamRequest) GetClientId() string {
+func (m *ObjectEvent) GetType() EventType {
 	if m != nil {
-		return m.ClientId
+		return m.Type
 	}
-	return ""
+	return EventType_Set
 }
 
-func (m *StreamRequest) GetKeys() []string {
+func (m *ObjectEvent) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Keys
+		return m.Object
 	}
 	return nil
 }
 
-type StreamResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
-func (m *StreamResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamResponse) ProtoMessage()    {}
-func (*StreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+func (m *ObjectEvent) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
 }
 
-func (m *StreamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamResponse.Unmarshal(m, b)
-}
-func (m *StreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamResponse.Marshal(b, m, deterministic)
-}
-func (m *StreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamResponse.Merge(m, src)
-}
-func (m *StreamResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamResponse.Size(m)
-}
-func (m *StreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamResponse.DiscardUnknown(m)
+func (m *ObjectEvent) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-var xxx_messageInfo_StreamResponse proto.InternalMessageInfo
-
-func (m *StreamResponse) GetObject() *ObjectDetail {
+func (m *ObjectEvent) GetPrevious() *Object {
 	if m != nil {
-		return m.Object
+		return m.Previous
 	}
 	return nil
 }
 
-type StreamRegexRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//A MetadataCondition compares the value of a metadata field
+type MetadataCondition struct {
+	Field                string           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Op                   MetadataOperator `protobuf:"varint,2,opt,name=op,proto3,enum=api.MetadataOperator" json:"op,omitempty"`
+	Values               []string         `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *StreamRegexRequest) Reset()         { *m = StreamRegexRequest{} }
-func (m *StreamRegexRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamRegexRequest) ProtoMessage()    {}
-func (*StreamRegexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+func (m *MetadataCondition) Reset()         { *m = MetadataCondition{} }
+func (m *MetadataCondition) String() string { return proto.CompactTextString(m) }
+func (*MetadataCondition) ProtoMessage()    {}
+func (*MetadataCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
-func (m *StreamRegexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRegexRequest.Unmarshal(m, b)
+func (m *MetadataCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetadataCondition.Unmarshal(m, b)
 }
-func (m *StreamRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRegexRequest.Marshal(b, m, deterministic)
+func (m *MetadataCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetadataCondition.Marshal(b, m, deterministic)
 }
-func (m *StreamRegexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRegexRequest.Merge(m, src)
+func (m *MetadataCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataCondition.Merge(m, src)
 }
-func (m *StreamRegexRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamRegexRequest.Size(m)
+func (m *MetadataCondition) XXX_Size() int {
+	return xxx_messageInfo_MetadataCondition.Size(m)
 }
-func (m *StreamRegexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRegexRequest.DiscardUnknown(m)
+func (m *MetadataCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataCondition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamRegexRequest proto.InternalMessageInfo
+var xxx_messageInfo_MetadataCondition proto.InternalMessageInfo
 
-func (m *StreamRegexRequest) GetClientId() string {
+func (m *MetadataCondition) GetField() string {
 	if m != nil {
-		return m.ClientId
+		return m.Field
 	}
 	return ""
 }
 
-func (m *StreamRegexRequest) GetRegex() string {
+func (m *MetadataCondition) GetOp() MetadataOperator {
 	if m != nil {
-		return m.Regex
+		return m.Op
 	}
-	return ""
+	return MetadataOperator_Equal
 }
 
-type StreamRegexResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *MetadataCondition) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *StreamRegexResponse) Reset()         { *m = StreamRegexResponse{} }
-func (m *StreamRegexResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamRegexResponse) ProtoMessage()    {}
-func (*StreamRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+//A MetadataFilter matches objects that satisfy all of its conditions
+type MetadataFilter struct {
+	Conditions           []*MetadataCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *StreamRegexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRegexResponse.Unmarshal(m, b)
+func (m *MetadataFilter) Reset()         { *m = MetadataFilter{} }
+func (m *MetadataFilter) String() string { return proto.CompactTextString(m) }
+func (*MetadataFilter) ProtoMessage()    {}
+func (*MetadataFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
-func (m *StreamRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRegexResponse.Marshal(b, m, deterministic)
+
+func (m *MetadataFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetadataFilter.Unmarshal(m, b)
 }
-func (m *StreamRegexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRegexResponse.Merge(m, src)
+func (m *MetadataFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetadataFilter.Marshal(b, m, deterministic)
 }
-func (m *StreamRegexResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamRegexResponse.Size(m)
+func (m *MetadataFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataFilter.Merge(m, src)
 }
-func (m *StreamRegexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRegexResponse.DiscardUnknown(m)
+func (m *MetadataFilter) XXX_Size() int {
+	return xxx_messageInfo_MetadataFilter.Size(m)
+}
+func (m *MetadataFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataFilter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamRegexResponse proto.InternalMessageInfo
+var xxx_messageInfo_MetadataFilter proto.InternalMessageInfo
 
-func (m *StreamRegexResponse) GetObject() *ObjectDetail {
+func (m *MetadataFilter) GetConditions() []*MetadataCondition {
 	if m != nil {
-		return m.Object
+		return m.Conditions
 	}
 	return nil
 }
 
-type StreamPrefixRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//A Geofence is a named, static area(circle or polygon) stored in the database. Exactly one of bound or polygon must be set.
+type Geofence struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bound                *Bound            `protobuf:"bytes,2,opt,name=bound,proto3" json:"bound,omitempty"`
+	Polygon              *Polygon          `protobuf:"bytes,3,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *StreamPrefixRequest) Reset()         { *m = StreamPrefixRequest{} }
-func (m *StreamPrefixRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamPrefixRequest) ProtoMessage()    {}
-func (*StreamPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+func (m *Geofence) Reset()         { *m = Geofence{} }
+func (m *Geofence) String() string { return proto.CompactTextString(m) }
+func (*Geofence) ProtoMessage()    {}
+func (*Geofence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *StreamPrefixRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamPrefixRequest.Unmarshal(m, b)
+func (m *Geofence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Geofence.Unmarshal(m, b)
 }
-func (m *StreamPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamPrefixRequest.Marshal(b, m, deterministic)
+func (m *Geofence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Geofence.Marshal(b, m, deterministic)
 }
-func (m *StreamPrefixRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPrefixRequest.Merge(m, src)
+func (m *Geofence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Geofence.Merge(m, src)
 }
-func (m *StreamPrefixRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamPrefixRequest.Size(m)
+func (m *Geofence) XXX_Size() int {
+	return xxx_messageInfo_Geofence.Size(m)
 }
-func (m *StreamPrefixRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPrefixRequest.DiscardUnknown(m)
+func (m *Geofence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Geofence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamPrefixRequest proto.InternalMessageInfo
+var xxx_messageInfo_Geofence proto.InternalMessageInfo
 
-func (m *StreamPrefixRequest) GetClientId() string {
+func (m *Geofence) GetName() string {
 	if m != nil {
-		return m.ClientId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *StreamPrefixRequest) GetPrefix() string {
+func (m *Geofence) GetBound() *Bound {
 	if m != nil {
-		return m.Prefix
+		return m.Bound
 	}
-	return ""
-}
-
-type StreamPrefixResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *StreamPrefixResponse) Reset()         { *m = StreamPrefixResponse{} }
-func (m *StreamPrefixResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamPrefixResponse) ProtoMessage()    {}
-func (*StreamPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return nil
 }
 
-func (m *StreamPrefixResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamPrefixResponse.Unmarshal(m, b)
-}
-func (m *StreamPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamPrefixResponse.Marshal(b, m, deterministic)
-}
-func (m *StreamPrefixResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPrefixResponse.Merge(m, src)
-}
-func (m *StreamPrefixResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamPrefixResponse.Size(m)
-}
-func (m *StreamPrefixResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPrefixResponse.DiscardUnknown(m)
+func (m *Geofence) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
 }
 
-var xxx_messageInfo_StreamPrefixResponse proto.InternalMessageInfo
-
-func (m *StreamPrefixResponse) GetObject() *ObjectDetail {
+func (m *Geofence) GetMetadata() map[string]string {
 	if m != nil {
-		return m.Object
+		return m.Metadata
 	}
 	return nil
 }
 
-type SetRequest struct {
-	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//GeofenceEvent is produced when an object enters or exits a geofence
+type GeofenceEvent struct {
+	Geofence             string     `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	Object               *Object    `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Transition           Transition `protobuf:"varint,3,opt,name=transition,proto3,enum=api.Transition" json:"transition,omitempty"`
+	TimestampUnix        int64      `protobuf:"varint,4,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *SetRequest) Reset()         { *m = SetRequest{} }
-func (m *SetRequest) String() string { return proto.CompactTextString(m) }
-func (*SetRequest) ProtoMessage()    {}
-func (*SetRequest) Descriptor() ([]byte, []int) {
+func (m *GeofenceEvent) Reset()         { *m = GeofenceEvent{} }
+func (m *GeofenceEvent) String() string { return proto.CompactTextString(m) }
+func (*GeofenceEvent) ProtoMessage()    {}
+func (*GeofenceEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *SetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
+func (m *GeofenceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeofenceEvent.Unmarshal(m, b)
 }
-func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
+func (m *GeofenceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeofenceEvent.Marshal(b, m, deterministic)
 }
-func (m *SetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRequest.Merge(m, src)
+func (m *GeofenceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeofenceEvent.Merge(m, src)
 }
-func (m *SetRequest) XXX_Size() int {
-	return xxx_messageInfo_SetRequest.Size(m)
+func (m *GeofenceEvent) XXX_Size() int {
+	return xxx_messageInfo_GeofenceEvent.Size(m)
 }
-func (m *SetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRequest.DiscardUnknown(m)
+func (m *GeofenceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeofenceEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetRequest proto.InternalMessageInfo
+var xxx_messageInfo_GeofenceEvent proto.InternalMessageInfo
 
-func (m *SetRequest) GetObject() *Object {
+func (m *GeofenceEvent) GetGeofence() string {
+	if m != nil {
+		return m.Geofence
+	}
+	return ""
+}
+
+func (m *GeofenceEvent) GetObject() *Object {
 	if m != nil {
 		return m.Object
 	}
 	return nil
 }
 
-type SetResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *GeofenceEvent) GetTransition() Transition {
+	if m != nil {
+		return m.Transition
+	}
+	return Transition_Outside
 }
 
-func (m *SetResponse) Reset()         { *m = SetResponse{} }
-func (m *SetResponse) String() string { return proto.CompactTextString(m) }
-func (*SetResponse) ProtoMessage()    {}
-func (*SetResponse) Descriptor() ([]byte, []int) {
+func (m *GeofenceEvent) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+type CreateMetadataIndexRequest struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateMetadataIndexRequest) Reset()         { *m = CreateMetadataIndexRequest{} }
+func (m *CreateMetadataIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMetadataIndexRequest) ProtoMessage()    {}
+func (*CreateMetadataIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-func (m *SetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
+func (m *CreateMetadataIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMetadataIndexRequest.Unmarshal(m, b)
 }
-func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
+func (m *CreateMetadataIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMetadataIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *SetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetResponse.Merge(m, src)
+func (m *CreateMetadataIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMetadataIndexRequest.Merge(m, src)
 }
-func (m *SetResponse) XXX_Size() int {
-	return xxx_messageInfo_SetResponse.Size(m)
+func (m *CreateMetadataIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateMetadataIndexRequest.Size(m)
 }
-func (m *SetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetResponse.DiscardUnknown(m)
+func (m *CreateMetadataIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMetadataIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateMetadataIndexRequest proto.InternalMessageInfo
 
-func (m *SetResponse) GetObject() *ObjectDetail {
+func (m *CreateMetadataIndexRequest) GetField() string {
 	if m != nil {
-		return m.Object
+		return m.Field
 	}
-	return nil
+	return ""
 }
 
-type GetKeysRequest struct {
+type CreateMetadataIndexResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
-func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetKeysRequest) ProtoMessage()    {}
-func (*GetKeysRequest) Descriptor() ([]byte, []int) {
+func (m *CreateMetadataIndexResponse) Reset()         { *m = CreateMetadataIndexResponse{} }
+func (m *CreateMetadataIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateMetadataIndexResponse) ProtoMessage()    {}
+func (*CreateMetadataIndexResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
-func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
+func (m *CreateMetadataIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMetadataIndexResponse.Unmarshal(m, b)
 }
-func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
+func (m *CreateMetadataIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMetadataIndexResponse.Marshal(b, m, deterministic)
 }
-func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysRequest.Merge(m, src)
+func (m *CreateMetadataIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMetadataIndexResponse.Merge(m, src)
 }
-func (m *GetKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetKeysRequest.Size(m)
+func (m *CreateMetadataIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateMetadataIndexResponse.Size(m)
 }
-func (m *GetKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
+func (m *CreateMetadataIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMetadataIndexResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateMetadataIndexResponse proto.InternalMessageInfo
 
-type GetKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+type DeleteMetadataIndexRequest struct {
+	Fields               []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
-func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKeysResponse) ProtoMessage()    {}
-func (*GetKeysResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteMetadataIndexRequest) Reset()         { *m = DeleteMetadataIndexRequest{} }
+func (m *DeleteMetadataIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMetadataIndexRequest) ProtoMessage()    {}
+func (*DeleteMetadataIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
-func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
+func (m *DeleteMetadataIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMetadataIndexRequest.Unmarshal(m, b)
 }
-func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
+func (m *DeleteMetadataIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMetadataIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysResponse.Merge(m, src)
+func (m *DeleteMetadataIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataIndexRequest.Merge(m, src)
 }
-func (m *GetKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKeysResponse.Size(m)
+func (m *DeleteMetadataIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMetadataIndexRequest.Size(m)
 }
-func (m *GetKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
+func (m *DeleteMetadataIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteMetadataIndexRequest proto.InternalMessageInfo
 
-func (m *GetKeysResponse) GetKeys() []string {
+func (m *DeleteMetadataIndexRequest) GetFields() []string {
 	if m != nil {
-		return m.Keys
+		return m.Fields
 	}
 	return nil
 }
 
-type GetPrefixKeysRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+type DeleteMetadataIndexResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
-func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysRequest) ProtoMessage()    {}
-func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteMetadataIndexResponse) Reset()         { *m = DeleteMetadataIndexResponse{} }
+func (m *DeleteMetadataIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMetadataIndexResponse) ProtoMessage()    {}
+func (*DeleteMetadataIndexResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
+func (m *DeleteMetadataIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMetadataIndexResponse.Unmarshal(m, b)
 }
-func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
+func (m *DeleteMetadataIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMetadataIndexResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
+func (m *DeleteMetadataIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataIndexResponse.Merge(m, src)
 }
-func (m *GetPrefixKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
+func (m *DeleteMetadataIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMetadataIndexResponse.Size(m)
 }
-func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
+func (m *DeleteMetadataIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataIndexResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
-
-func (m *GetPrefixKeysRequest) GetPrefix() string {
-	if m != nil {
-		return m.Prefix
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteMetadataIndexResponse proto.InternalMessageInfo
 
-type GetPrefixKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+type ListMetadataIndexesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
-func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysResponse) ProtoMessage()    {}
-func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
+func (m *ListMetadataIndexesRequest) Reset()         { *m = ListMetadataIndexesRequest{} }
+func (m *ListMetadataIndexesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataIndexesRequest) ProtoMessage()    {}
+func (*ListMetadataIndexesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+func (m *ListMetadataIndexesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMetadataIndexesRequest.Unmarshal(m, b)
 }
-func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+func (m *ListMetadataIndexesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMetadataIndexesRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+func (m *ListMetadataIndexesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataIndexesRequest.Merge(m, src)
 }
-func (m *GetPrefixKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+func (m *ListMetadataIndexesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMetadataIndexesRequest.Size(m)
 }
-func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
+func (m *ListMetadataIndexesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataIndexesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
-
-func (m *GetPrefixKeysResponse) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
+var xxx_messageInfo_ListMetadataIndexesRequest proto.InternalMessageInfo
 
-type GetRegexKeysRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+type ListMetadataIndexesResponse struct {
+	Fields               []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
-func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysRequest) ProtoMessage()    {}
-func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
+func (m *ListMetadataIndexesResponse) Reset()         { *m = ListMetadataIndexesResponse{} }
+func (m *ListMetadataIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataIndexesResponse) ProtoMessage()    {}
+func (*ListMetadataIndexesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
+func (m *ListMetadataIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMetadataIndexesResponse.Unmarshal(m, b)
 }
-func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+func (m *ListMetadataIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMetadataIndexesResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+func (m *ListMetadataIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataIndexesResponse.Merge(m, src)
 }
-func (m *GetRegexKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+func (m *ListMetadataIndexesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMetadataIndexesResponse.Size(m)
 }
-func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
+func (m *ListMetadataIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataIndexesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListMetadataIndexesResponse proto.InternalMessageInfo
 
-func (m *GetRegexKeysRequest) GetRegex() string {
+func (m *ListMetadataIndexesResponse) GetFields() []string {
 	if m != nil {
-		return m.Regex
+		return m.Fields
 	}
-	return ""
+	return nil
 }
 
-type GetRegexKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateGeofenceRequest struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
-func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysResponse) ProtoMessage()    {}
-func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
+func (m *CreateGeofenceRequest) Reset()         { *m = CreateGeofenceRequest{} }
+func (m *CreateGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGeofenceRequest) ProtoMessage()    {}
+func (*CreateGeofenceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 
-func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
+func (m *CreateGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGeofenceRequest.Unmarshal(m, b)
 }
-func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
+func (m *CreateGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGeofenceRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
+func (m *CreateGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGeofenceRequest.Merge(m, src)
 }
-func (m *GetRegexKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
+func (m *CreateGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGeofenceRequest.Size(m)
 }
-func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+func (m *CreateGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGeofenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateGeofenceRequest proto.InternalMessageInfo
 
-func (m *GetRegexKeysResponse) GetKeys() []string {
+func (m *CreateGeofenceRequest) GetGeofence() *Geofence {
 	if m != nil {
-		return m.Keys
+		return m.Geofence
 	}
 	return nil
 }
 
-type GetRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateGeofenceResponse struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
+func (m *CreateGeofenceResponse) Reset()         { *m = CreateGeofenceResponse{} }
+func (m *CreateGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGeofenceResponse) ProtoMessage()    {}
+func (*CreateGeofenceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+func (m *CreateGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGeofenceResponse.Unmarshal(m, b)
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+func (m *CreateGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGeofenceResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+func (m *CreateGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGeofenceResponse.Merge(m, src)
 }
-func (m *GetRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRequest.Size(m)
+func (m *CreateGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateGeofenceResponse.Size(m)
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+func (m *CreateGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGeofenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateGeofenceResponse proto.InternalMessageInfo
 
-func (m *GetRequest) GetKeys() []string {
+func (m *CreateGeofenceResponse) GetGeofence() *Geofence {
 	if m != nil {
-		return m.Keys
+		return m.Geofence
 	}
 	return nil
 }
 
-type GetResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type DeleteGeofenceRequest struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetResponse) Reset()         { *m = GetResponse{} }
-func (m *GetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()    {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteGeofenceRequest) Reset()         { *m = DeleteGeofenceRequest{} }
+func (m *DeleteGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofenceRequest) ProtoMessage()    {}
+func (*DeleteGeofenceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+func (m *DeleteGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofenceRequest.Unmarshal(m, b)
 }
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+func (m *DeleteGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofenceRequest.Marshal(b, m, deterministic)
 }
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
+func (m *DeleteGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofenceRequest.Merge(m, src)
 }
-func (m *GetResponse) XXX_Size() int {
-	return xxx_messageInfo_GetResponse.Size(m)
+func (m *DeleteGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofenceRequest.Size(m)
 }
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *DeleteGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteGeofenceRequest proto.InternalMessageInfo
 
-func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+func (m *DeleteGeofenceRequest) GetNames() []string {
 	if m != nil {
-		return m.Objects
+		return m.Names
 	}
 	return nil
 }
 
-type GetRegexRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+type DeleteGeofenceResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
-func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexRequest) ProtoMessage()    {}
-func (*GetRegexRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteGeofenceResponse) Reset()         { *m = DeleteGeofenceResponse{} }
+func (m *DeleteGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofenceResponse) ProtoMessage()    {}
+func (*DeleteGeofenceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
-func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+func (m *DeleteGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofenceResponse.Unmarshal(m, b)
 }
-func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+func (m *DeleteGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofenceResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+func (m *DeleteGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofenceResponse.Merge(m, src)
 }
-func (m *GetRegexRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexRequest.Size(m)
+func (m *DeleteGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofenceResponse.Size(m)
 }
-func (m *GetRegexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+func (m *DeleteGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteGeofenceResponse proto.InternalMessageInfo
 
-func (m *GetRegexRequest) GetRegex() string {
-	if m != nil {
-		return m.Regex
-	}
-	return ""
+type ListGeofencesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GetRegexResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ListGeofencesRequest) Reset()         { *m = ListGeofencesRequest{} }
+func (m *ListGeofencesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGeofencesRequest) ProtoMessage()    {}
+func (*ListGeofencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 
-func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
-func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexResponse) ProtoMessage()    {}
-func (*GetRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *ListGeofencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGeofencesRequest.Unmarshal(m, b)
+}
+func (m *ListGeofencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGeofencesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGeofencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGeofencesRequest.Merge(m, src)
+}
+func (m *ListGeofencesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGeofencesRequest.Size(m)
+}
+func (m *ListGeofencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGeofencesRequest.DiscardUnknown(m)
 }
 
-func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+var xxx_messageInfo_ListGeofencesRequest proto.InternalMessageInfo
+
+type ListGeofencesResponse struct {
+	Geofences            []*Geofence `protobuf:"bytes,1,rep,name=geofences,proto3" json:"geofences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+
+func (m *ListGeofencesResponse) Reset()         { *m = ListGeofencesResponse{} }
+func (m *ListGeofencesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGeofencesResponse) ProtoMessage()    {}
+func (*ListGeofencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
-func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+
+func (m *ListGeofencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGeofencesResponse.Unmarshal(m, b)
 }
-func (m *GetRegexResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexResponse.Size(m)
+func (m *ListGeofencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGeofencesResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRegexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+func (m *ListGeofencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGeofencesResponse.Merge(m, src)
+}
+func (m *ListGeofencesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGeofencesResponse.Size(m)
+}
+func (m *ListGeofencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGeofencesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListGeofencesResponse proto.InternalMessageInfo
 
-func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+func (m *ListGeofencesResponse) GetGeofences() []*Geofence {
 	if m != nil {
-		return m.Objects
+		return m.Geofences
 	}
 	return nil
 }
 
-type GetPrefixRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+type GetGeofenceEventsRequest struct {
+	Geofence             string   `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	FromUnix             int64    `protobuf:"varint,3,opt,name=from_unix,json=fromUnix,proto3" json:"from_unix,omitempty"`
+	ToUnix               int64    `protobuf:"varint,4,opt,name=to_unix,json=toUnix,proto3" json:"to_unix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
-func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixRequest) ProtoMessage()    {}
-func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *GetGeofenceEventsRequest) Reset()         { *m = GetGeofenceEventsRequest{} }
+func (m *GetGeofenceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGeofenceEventsRequest) ProtoMessage()    {}
+func (*GetGeofenceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 
-func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+func (m *GetGeofenceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGeofenceEventsRequest.Unmarshal(m, b)
 }
-func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+func (m *GetGeofenceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGeofenceEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+func (m *GetGeofenceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGeofenceEventsRequest.Merge(m, src)
 }
-func (m *GetPrefixRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixRequest.Size(m)
+func (m *GetGeofenceEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGeofenceEventsRequest.Size(m)
 }
-func (m *GetPrefixRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+func (m *GetGeofenceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGeofenceEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetGeofenceEventsRequest proto.InternalMessageInfo
 
-func (m *GetPrefixRequest) GetPrefix() string {
+func (m *GetGeofenceEventsRequest) GetGeofence() string {
 	if m != nil {
-		return m.Prefix
+		return m.Geofence
 	}
 	return ""
 }
 
-type GetPrefixResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
-func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixResponse) ProtoMessage()    {}
-func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *GetGeofenceEventsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
-}
-func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
-}
-func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
-}
-func (m *GetPrefixResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixResponse.Size(m)
-}
-func (m *GetPrefixResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+func (m *GetGeofenceEventsRequest) GetFromUnix() int64 {
+	if m != nil {
+		return m.FromUnix
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
-
-func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+func (m *GetGeofenceEventsRequest) GetToUnix() int64 {
 	if m != nil {
-		return m.Objects
+		return m.ToUnix
 	}
-	return nil
+	return 0
 }
 
-type DeleteRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetGeofenceEventsResponse struct {
+	Events               []*GeofenceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+func (m *GetGeofenceEventsResponse) Reset()         { *m = GetGeofenceEventsResponse{} }
+func (m *GetGeofenceEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGeofenceEventsResponse) ProtoMessage()    {}
+func (*GetGeofenceEventsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *GetGeofenceEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGeofenceEventsResponse.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *GetGeofenceEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGeofenceEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *GetGeofenceEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGeofenceEventsResponse.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *GetGeofenceEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGeofenceEventsResponse.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *GetGeofenceEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGeofenceEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetGeofenceEventsResponse proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetKeys() []string {
+func (m *GetGeofenceEventsResponse) GetEvents() []*GeofenceEvent {
 	if m != nil {
-		return m.Keys
+		return m.Events
 	}
 	return nil
 }
 
-type DeleteResponse struct {
+type StreamGeofenceRequest struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Names                []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
-func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteResponse) ProtoMessage()    {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+func (m *StreamGeofenceRequest) Reset()         { *m = StreamGeofenceRequest{} }
+func (m *StreamGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamGeofenceRequest) ProtoMessage()    {}
+func (*StreamGeofenceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+func (m *StreamGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamGeofenceRequest.Unmarshal(m, b)
 }
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+func (m *StreamGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamGeofenceRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
+func (m *StreamGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGeofenceRequest.Merge(m, src)
 }
-func (m *DeleteResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteResponse.Size(m)
+func (m *StreamGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamGeofenceRequest.Size(m)
 }
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+func (m *StreamGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGeofenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamGeofenceRequest proto.InternalMessageInfo
 
-type ScanBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamGeofenceRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
-func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundRequest) ProtoMessage()    {}
-func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
+func (m *StreamGeofenceRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type StreamGeofenceResponse struct {
+	Event                *GeofenceEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StreamGeofenceResponse) Reset()         { *m = StreamGeofenceResponse{} }
+func (m *StreamGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamGeofenceResponse) ProtoMessage()    {}
+func (*StreamGeofenceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+func (m *StreamGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamGeofenceResponse.Unmarshal(m, b)
 }
-func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+func (m *StreamGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamGeofenceResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+func (m *StreamGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGeofenceResponse.Merge(m, src)
 }
-func (m *ScanBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundRequest.Size(m)
+func (m *StreamGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamGeofenceResponse.Size(m)
 }
-func (m *ScanBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+func (m *StreamGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGeofenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamGeofenceResponse proto.InternalMessageInfo
 
-func (m *ScanBoundRequest) GetBound() *Bound {
+func (m *StreamGeofenceResponse) GetEvent() *GeofenceEvent {
 	if m != nil {
-		return m.Bound
+		return m.Event
 	}
 	return nil
 }
 
-func (m *ScanBoundRequest) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
-
-type ScanBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type StreamRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Keys                 []string          `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResumeSequence       uint64            `protobuf:"varint,4,opt,name=resume_sequence,json=resumeSequence,proto3" json:"resume_sequence,omitempty"`
+	SinceUnix            int64             `protobuf:"varint,5,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
-func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundResponse) ProtoMessage()    {}
-func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
 }
-func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
 }
-func (m *ScanBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundResponse.Size(m)
+func (m *StreamRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRequest.Size(m)
 }
-func (m *ScanBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
 
-func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamRequest) GetClientId() string {
 	if m != nil {
-		return m.Objects
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
 	return nil
 }
 
-type ScanPrefixBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
-func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundRequest) ProtoMessage()    {}
-func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
+func (m *StreamRequest) GetResumeSequence() uint64 {
+	if m != nil {
+		return m.ResumeSequence
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+type StreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Type                 EventType     `protobuf:"varint,2,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
+func (m *StreamResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamResponse) ProtoMessage()    {}
+func (*StreamResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+func (m *StreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamResponse.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+func (m *StreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+func (m *StreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamResponse.Merge(m, src)
 }
-func (m *ScanPrefixBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+func (m *StreamResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamResponse.Size(m)
 }
-func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+func (m *StreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamResponse proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+func (m *StreamResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Bound
+		return m.Object
 	}
 	return nil
 }
 
-func (m *ScanPrefixBoundRequest) GetPrefix() string {
+func (m *StreamResponse) GetType() EventType {
 	if m != nil {
-		return m.Prefix
+		return m.Type
 	}
-	return ""
+	return EventType_Set
 }
 
-type ScanPrefixBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *StreamResponse) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
 }
 
-func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
-func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundResponse) ProtoMessage()    {}
-func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
+func (m *StreamResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type StreamRegexRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Regex                string            `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResumeSequence       uint64            `protobuf:"varint,4,opt,name=resume_sequence,json=resumeSequence,proto3" json:"resume_sequence,omitempty"`
+	SinceUnix            int64             `protobuf:"varint,5,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StreamRegexRequest) Reset()         { *m = StreamRegexRequest{} }
+func (m *StreamRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRegexRequest) ProtoMessage()    {}
+func (*StreamRegexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+func (m *StreamRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRegexRequest.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+func (m *StreamRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRegexRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+func (m *StreamRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRegexRequest.Merge(m, src)
 }
-func (m *ScanPrefixBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+func (m *StreamRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRegexRequest.Size(m)
 }
-func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+func (m *StreamRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRegexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamRegexRequest proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamRegexRequest) GetClientId() string {
 	if m != nil {
-		return m.Objects
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamRegexRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *StreamRegexRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-type ScanRegexBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamRegexRequest) GetResumeSequence() uint64 {
+	if m != nil {
+		return m.ResumeSequence
+	}
+	return 0
 }
 
-func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
-func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundRequest) ProtoMessage()    {}
-func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
+func (m *StreamRegexRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+type StreamRegexResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Type                 EventType     `protobuf:"varint,2,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamRegexResponse) Reset()         { *m = StreamRegexResponse{} }
+func (m *StreamRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamRegexResponse) ProtoMessage()    {}
+func (*StreamRegexResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+func (m *StreamRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRegexResponse.Unmarshal(m, b)
 }
-func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+func (m *StreamRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRegexResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+func (m *StreamRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRegexResponse.Merge(m, src)
 }
-func (m *ScanRegexBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+func (m *StreamRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamRegexResponse.Size(m)
 }
-func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+func (m *StreamRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRegexResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamRegexResponse proto.InternalMessageInfo
 
-func (m *ScanRegexBoundRequest) GetBound() *Bound {
+func (m *StreamRegexResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Bound
+		return m.Object
 	}
 	return nil
 }
 
-func (m *ScanRegexBoundRequest) GetRegex() string {
+func (m *StreamRegexResponse) GetType() EventType {
 	if m != nil {
-		return m.Regex
+		return m.Type
 	}
-	return ""
+	return EventType_Set
 }
 
-type ScanRegexBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
-func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundResponse) ProtoMessage()    {}
-func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+func (m *StreamRegexResponse) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
 }
 
-func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+func (m *StreamRegexResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
-func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+
+type StreamPrefixRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Prefix               string            `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResumeSequence       uint64            `protobuf:"varint,4,opt,name=resume_sequence,json=resumeSequence,proto3" json:"resume_sequence,omitempty"`
+	SinceUnix            int64             `protobuf:"varint,5,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+
+func (m *StreamPrefixRequest) Reset()         { *m = StreamPrefixRequest{} }
+func (m *StreamPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPrefixRequest) ProtoMessage()    {}
+func (*StreamPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
-func (m *ScanRegexBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+
+func (m *StreamPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPrefixRequest.Unmarshal(m, b)
 }
-func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+func (m *StreamPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPrefixRequest.Merge(m, src)
+}
+func (m *StreamPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPrefixRequest.Size(m)
+}
+func (m *StreamPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPrefixRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamPrefixRequest proto.InternalMessageInfo
 
-func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamPrefixRequest) GetClientId() string {
 	if m != nil {
-		return m.Objects
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *StreamPrefixRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-type GetPointRequest struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamPrefixRequest) GetResumeSequence() uint64 {
+	if m != nil {
+		return m.ResumeSequence
+	}
+	return 0
 }
 
-func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
-func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPointRequest) ProtoMessage()    {}
-func (*GetPointRequest) Descriptor() ([]byte, []int) {
+func (m *StreamPrefixRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+type StreamPrefixResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Type                 EventType     `protobuf:"varint,2,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamPrefixResponse) Reset()         { *m = StreamPrefixResponse{} }
+func (m *StreamPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamPrefixResponse) ProtoMessage()    {}
+func (*StreamPrefixResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+func (m *StreamPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPrefixResponse.Unmarshal(m, b)
 }
-func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+func (m *StreamPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPrefixResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPointRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointRequest.Merge(m, src)
+func (m *StreamPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPrefixResponse.Merge(m, src)
 }
-func (m *GetPointRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPointRequest.Size(m)
+func (m *StreamPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamPrefixResponse.Size(m)
 }
-func (m *GetPointRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+func (m *StreamPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPrefixResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamPrefixResponse proto.InternalMessageInfo
 
-func (m *GetPointRequest) GetAddress() string {
+func (m *StreamPrefixResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Address
+		return m.Object
 	}
-	return ""
+	return nil
 }
 
-type GetPointResponse struct {
-	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamPrefixResponse) GetType() EventType {
+	if m != nil {
+		return m.Type
+	}
+	return EventType_Set
 }
 
-func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
-func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPointResponse) ProtoMessage()    {}
-func (*GetPointResponse) Descriptor() ([]byte, []int) {
+func (m *StreamPrefixResponse) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+func (m *StreamPrefixResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type StreamBoundRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Bound                *Bound            `protobuf:"bytes,2,opt,name=bound,proto3" json:"bound,omitempty"`
+	Keys                 []string          `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResumeSequence       uint64            `protobuf:"varint,5,opt,name=resume_sequence,json=resumeSequence,proto3" json:"resume_sequence,omitempty"`
+	SinceUnix            int64             `protobuf:"varint,6,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StreamBoundRequest) Reset()         { *m = StreamBoundRequest{} }
+func (m *StreamBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBoundRequest) ProtoMessage()    {}
+func (*StreamBoundRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+func (m *StreamBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBoundRequest.Unmarshal(m, b)
 }
-func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+func (m *StreamBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBoundRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointResponse.Merge(m, src)
+func (m *StreamBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBoundRequest.Merge(m, src)
 }
-func (m *GetPointResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPointResponse.Size(m)
+func (m *StreamBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamBoundRequest.Size(m)
 }
-func (m *GetPointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+func (m *StreamBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBoundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamBoundRequest proto.InternalMessageInfo
 
-func (m *GetPointResponse) GetPoint() *Point {
+func (m *StreamBoundRequest) GetClientId() string {
 	if m != nil {
-		return m.Point
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
 	}
 	return nil
 }
 
-type PingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamBoundRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
 
-func (m *PingRequest) Reset()         { *m = PingRequest{} }
-func (m *PingRequest) String() string { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()    {}
-func (*PingRequest) Descriptor() ([]byte, []int) {
+func (m *StreamBoundRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StreamBoundRequest) GetResumeSequence() uint64 {
+	if m != nil {
+		return m.ResumeSequence
+	}
+	return 0
+}
+
+func (m *StreamBoundRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+type StreamBoundResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Type                 EventType     `protobuf:"varint,2,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamBoundResponse) Reset()         { *m = StreamBoundResponse{} }
+func (m *StreamBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamBoundResponse) ProtoMessage()    {}
+func (*StreamBoundResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (m *PingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+func (m *StreamBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBoundResponse.Unmarshal(m, b)
 }
-func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+func (m *StreamBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBoundResponse.Marshal(b, m, deterministic)
 }
-func (m *PingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingRequest.Merge(m, src)
+func (m *StreamBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBoundResponse.Merge(m, src)
 }
-func (m *PingRequest) XXX_Size() int {
-	return xxx_messageInfo_PingRequest.Size(m)
+func (m *StreamBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamBoundResponse.Size(m)
 }
-func (m *PingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+func (m *StreamBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBoundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamBoundResponse proto.InternalMessageInfo
 
-type PingResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamBoundResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
 }
 
-func (m *PingResponse) Reset()         { *m = PingResponse{} }
-func (m *PingResponse) String() string { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()    {}
-func (*PingResponse) Descriptor() ([]byte, []int) {
+func (m *StreamBoundResponse) GetType() EventType {
+	if m != nil {
+		return m.Type
+	}
+	return EventType_Set
+}
+
+func (m *StreamBoundResponse) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+func (m *StreamBoundResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type StreamPolygonRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Polygon              *Polygon          `protobuf:"bytes,2,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Keys                 []string          `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResumeSequence       uint64            `protobuf:"varint,5,opt,name=resume_sequence,json=resumeSequence,proto3" json:"resume_sequence,omitempty"`
+	SinceUnix            int64             `protobuf:"varint,6,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StreamPolygonRequest) Reset()         { *m = StreamPolygonRequest{} }
+func (m *StreamPolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPolygonRequest) ProtoMessage()    {}
+func (*StreamPolygonRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *PingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+func (m *StreamPolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPolygonRequest.Unmarshal(m, b)
 }
-func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+func (m *StreamPolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPolygonRequest.Marshal(b, m, deterministic)
 }
-func (m *PingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingResponse.Merge(m, src)
+func (m *StreamPolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPolygonRequest.Merge(m, src)
 }
-func (m *PingResponse) XXX_Size() int {
-	return xxx_messageInfo_PingResponse.Size(m)
+func (m *StreamPolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPolygonRequest.Size(m)
 }
-func (m *PingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+func (m *StreamPolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPolygonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamPolygonRequest proto.InternalMessageInfo
 
-func (m *PingResponse) GetOk() bool {
+func (m *StreamPolygonRequest) GetClientId() string {
 	if m != nil {
-		return m.Ok
+		return m.ClientId
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
+func (m *StreamPolygonRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *StreamPolygonRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *StreamPolygonRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StreamPolygonRequest) GetResumeSequence() uint64 {
+	if m != nil {
+		return m.ResumeSequence
+	}
+	return 0
+}
+
+func (m *StreamPolygonRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+type StreamPolygonResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Type                 EventType     `protobuf:"varint,2,opt,name=type,proto3,enum=api.EventType" json:"type,omitempty"`
+	TimestampUnix        int64         `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Sequence             uint64        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamPolygonResponse) Reset()         { *m = StreamPolygonResponse{} }
+func (m *StreamPolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamPolygonResponse) ProtoMessage()    {}
+func (*StreamPolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *StreamPolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPolygonResponse.Unmarshal(m, b)
+}
+func (m *StreamPolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamPolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPolygonResponse.Merge(m, src)
+}
+func (m *StreamPolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamPolygonResponse.Size(m)
+}
+func (m *StreamPolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPolygonResponse proto.InternalMessageInfo
+
+func (m *StreamPolygonResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *StreamPolygonResponse) GetType() EventType {
+	if m != nil {
+		return m.Type
+	}
+	return EventType_Set
+}
+
+func (m *StreamPolygonResponse) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+func (m *StreamPolygonResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type SetRequest struct {
+	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRequest) Reset()         { *m = SetRequest{} }
+func (m *SetRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRequest) ProtoMessage()    {}
+func (*SetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *SetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
+}
+func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRequest.Merge(m, src)
+}
+func (m *SetRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRequest.Size(m)
+}
+func (m *SetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRequest proto.InternalMessageInfo
+
+func (m *SetRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type SetResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetResponse) Reset()         { *m = SetResponse{} }
+func (m *SetResponse) String() string { return proto.CompactTextString(m) }
+func (*SetResponse) ProtoMessage()    {}
+func (*SetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *SetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
+}
+func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
+}
+func (m *SetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResponse.Merge(m, src)
+}
+func (m *SetResponse) XXX_Size() int {
+	return xxx_messageInfo_SetResponse.Size(m)
+}
+func (m *SetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetResponse proto.InternalMessageInfo
+
+func (m *SetResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type SetBatchRequest struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetBatchRequest) Reset()         { *m = SetBatchRequest{} }
+func (m *SetBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBatchRequest) ProtoMessage()    {}
+func (*SetBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *SetBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBatchRequest.Unmarshal(m, b)
+}
+func (m *SetBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBatchRequest.Merge(m, src)
+}
+func (m *SetBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBatchRequest.Size(m)
+}
+func (m *SetBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBatchRequest proto.InternalMessageInfo
+
+func (m *SetBatchRequest) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+//A SetResult is the outcome of setting a single object of a batch
+type SetResult struct {
+	Key                  string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Object               *ObjectDetail `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Error                string        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetResult) Reset()         { *m = SetResult{} }
+func (m *SetResult) String() string { return proto.CompactTextString(m) }
+func (*SetResult) ProtoMessage()    {}
+func (*SetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *SetResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetResult.Unmarshal(m, b)
+}
+func (m *SetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetResult.Marshal(b, m, deterministic)
+}
+func (m *SetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResult.Merge(m, src)
+}
+func (m *SetResult) XXX_Size() int {
+	return xxx_messageInfo_SetResult.Size(m)
+}
+func (m *SetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetResult proto.InternalMessageInfo
+
+func (m *SetResult) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetResult) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *SetResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SetBatchResponse struct {
+	Results              []*SetResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetBatchResponse) Reset()         { *m = SetBatchResponse{} }
+func (m *SetBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBatchResponse) ProtoMessage()    {}
+func (*SetBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *SetBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBatchResponse.Unmarshal(m, b)
+}
+func (m *SetBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBatchResponse.Merge(m, src)
+}
+func (m *SetBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBatchResponse.Size(m)
+}
+func (m *SetBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBatchResponse proto.InternalMessageInfo
+
+func (m *SetBatchResponse) GetResults() []*SetResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SetStreamResponse struct {
+	Results              []*SetResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Set                  int64        `protobuf:"varint,2,opt,name=set,proto3" json:"set,omitempty"`
+	Failed               int64        `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetStreamResponse) Reset()         { *m = SetStreamResponse{} }
+func (m *SetStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStreamResponse) ProtoMessage()    {}
+func (*SetStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *SetStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetStreamResponse.Unmarshal(m, b)
+}
+func (m *SetStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *SetStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamResponse.Merge(m, src)
+}
+func (m *SetStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_SetStreamResponse.Size(m)
+}
+func (m *SetStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStreamResponse proto.InternalMessageInfo
+
+func (m *SetStreamResponse) GetResults() []*SetResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SetStreamResponse) GetSet() int64 {
+	if m != nil {
+		return m.Set
+	}
+	return 0
+}
+
+func (m *SetStreamResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+type GetKeysRequest struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
+func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeysRequest) ProtoMessage()    {}
+func (*GetKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
+}
+func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysRequest.Merge(m, src)
+}
+func (m *GetKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeysRequest.Size(m)
+}
+func (m *GetKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
+
+func (m *GetKeysRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetKeysRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
+func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeysResponse) ProtoMessage()    {}
+func (*GetKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
+}
+func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysResponse.Merge(m, src)
+}
+func (m *GetKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeysResponse.Size(m)
+}
+func (m *GetKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
+
+func (m *GetKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetKeysResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetPrefixKeysRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
+func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysRequest) ProtoMessage()    {}
+func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
+}
+func (m *GetPrefixKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
+}
+func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
+
+func (m *GetPrefixKeysRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GetPrefixKeysRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetPrefixKeysRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetPrefixKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
+func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysResponse) ProtoMessage()    {}
+func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+}
+func (m *GetPrefixKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+}
+func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
+
+func (m *GetPrefixKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetPrefixKeysResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetRegexKeysRequest struct {
+	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
+func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysRequest) ProtoMessage()    {}
+func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
+}
+func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+}
+func (m *GetRegexKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+}
+func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+
+func (m *GetRegexKeysRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GetRegexKeysRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRegexKeysRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetRegexKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
+func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysResponse) ProtoMessage()    {}
+func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
+}
+func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
+}
+func (m *GetRegexKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
+}
+func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
+
+func (m *GetRegexKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetRegexKeysResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetRequest struct {
+	Keys                 []string        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResponse.Size(m)
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetRegexRequest struct {
+	Regex                string          `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
+func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexRequest) ProtoMessage()    {}
+func (*GetRegexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+}
+func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+}
+func (m *GetRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexRequest.Size(m)
+}
+func (m *GetRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+
+func (m *GetRegexRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetRegexRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRegexRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetRegexResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
+func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexResponse) ProtoMessage()    {}
+func (*GetRegexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+}
+func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+}
+func (m *GetRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexResponse.Size(m)
+}
+func (m *GetRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+
+func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetRegexResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetPrefixRequest struct {
+	Prefix               string          `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
+func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixRequest) ProtoMessage()    {}
+func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+}
+func (m *GetPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixRequest.Size(m)
+}
+func (m *GetPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+
+func (m *GetPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GetPrefixRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetPrefixRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetPrefixRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetPrefixResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
+func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixResponse) ProtoMessage()    {}
+func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
+}
+func (m *GetPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixResponse.Size(m)
+}
+func (m *GetPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
+
+func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetPrefixResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetStreamResponse) Reset()         { *m = GetStreamResponse{} }
+func (m *GetStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStreamResponse) ProtoMessage()    {}
+func (*GetStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *GetStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStreamResponse.Unmarshal(m, b)
+}
+func (m *GetStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStreamResponse.Merge(m, src)
+}
+func (m *GetStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStreamResponse.Size(m)
+}
+func (m *GetStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStreamResponse proto.InternalMessageInfo
+
+func (m *GetStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *GetStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetRegexStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetRegexStreamResponse) Reset()         { *m = GetRegexStreamResponse{} }
+func (m *GetRegexStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexStreamResponse) ProtoMessage()    {}
+func (*GetRegexStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *GetRegexStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexStreamResponse.Unmarshal(m, b)
+}
+func (m *GetRegexStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexStreamResponse.Merge(m, src)
+}
+func (m *GetRegexStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexStreamResponse.Size(m)
+}
+func (m *GetRegexStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexStreamResponse proto.InternalMessageInfo
+
+func (m *GetRegexStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *GetRegexStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetPrefixStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetPrefixStreamResponse) Reset()         { *m = GetPrefixStreamResponse{} }
+func (m *GetPrefixStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixStreamResponse) ProtoMessage()    {}
+func (*GetPrefixStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *GetPrefixStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixStreamResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixStreamResponse.Merge(m, src)
+}
+func (m *GetPrefixStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixStreamResponse.Size(m)
+}
+func (m *GetPrefixStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixStreamResponse proto.InternalMessageInfo
+
+func (m *GetPrefixStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *GetPrefixStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+type ScanBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
+func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundRequest) ProtoMessage()    {}
+func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+}
+func (m *ScanBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundRequest.Size(m)
+}
+func (m *ScanBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+
+func (m *ScanBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ScanBoundRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
+func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundResponse) ProtoMessage()    {}
+func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+}
+func (m *ScanBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundResponse.Size(m)
+}
+func (m *ScanBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+
+func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanBoundResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ScanPrefixBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Prefix               string          `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
+func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundRequest) ProtoMessage()    {}
+func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+}
+func (m *ScanPrefixBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+}
+func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanPrefixBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ScanPrefixBoundRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanPrefixBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
+func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundResponse) ProtoMessage()    {}
+func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+}
+func (m *ScanPrefixBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+}
+func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ScanRegexBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Regex                string          `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
+func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundRequest) ProtoMessage()    {}
+func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+}
+func (m *ScanRegexBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+}
+func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+
+func (m *ScanRegexBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanRegexBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ScanRegexBoundRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanRegexBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
+func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundResponse) ProtoMessage()    {}
+func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+}
+func (m *ScanRegexBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+}
+func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+
+func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ScanPolygonRequest struct {
+	Polygon              *Polygon        `protobuf:"bytes,1,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit                int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string          `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPolygonRequest) Reset()         { *m = ScanPolygonRequest{} }
+func (m *ScanPolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPolygonRequest) ProtoMessage()    {}
+func (*ScanPolygonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *ScanPolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPolygonRequest.Unmarshal(m, b)
+}
+func (m *ScanPolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPolygonRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPolygonRequest.Merge(m, src)
+}
+func (m *ScanPolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPolygonRequest.Size(m)
+}
+func (m *ScanPolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPolygonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPolygonRequest proto.InternalMessageInfo
+
+func (m *ScanPolygonRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ScanPolygonRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanPolygonResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPolygonResponse) Reset()         { *m = ScanPolygonResponse{} }
+func (m *ScanPolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPolygonResponse) ProtoMessage()    {}
+func (*ScanPolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *ScanPolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPolygonResponse.Unmarshal(m, b)
+}
+func (m *ScanPolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPolygonResponse.Merge(m, src)
+}
+func (m *ScanPolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPolygonResponse.Size(m)
+}
+func (m *ScanPolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPolygonResponse proto.InternalMessageInfo
+
+func (m *ScanPolygonResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPolygonResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ScanBoundStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScanBoundStreamResponse) Reset()         { *m = ScanBoundStreamResponse{} }
+func (m *ScanBoundStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundStreamResponse) ProtoMessage()    {}
+func (*ScanBoundStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *ScanBoundStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundStreamResponse.Unmarshal(m, b)
+}
+func (m *ScanBoundStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundStreamResponse.Merge(m, src)
+}
+func (m *ScanBoundStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundStreamResponse.Size(m)
+}
+func (m *ScanBoundStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundStreamResponse proto.InternalMessageInfo
+
+func (m *ScanBoundStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ScanBoundStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanRegexBoundStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScanRegexBoundStreamResponse) Reset()         { *m = ScanRegexBoundStreamResponse{} }
+func (m *ScanRegexBoundStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundStreamResponse) ProtoMessage()    {}
+func (*ScanRegexBoundStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *ScanRegexBoundStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundStreamResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundStreamResponse.Merge(m, src)
+}
+func (m *ScanRegexBoundStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundStreamResponse.Size(m)
+}
+func (m *ScanRegexBoundStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundStreamResponse proto.InternalMessageInfo
+
+func (m *ScanRegexBoundStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanPrefixBoundStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScanPrefixBoundStreamResponse) Reset()         { *m = ScanPrefixBoundStreamResponse{} }
+func (m *ScanPrefixBoundStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundStreamResponse) ProtoMessage()    {}
+func (*ScanPrefixBoundStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *ScanPrefixBoundStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundStreamResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundStreamResponse.Merge(m, src)
+}
+func (m *ScanPrefixBoundStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundStreamResponse.Size(m)
+}
+func (m *ScanPrefixBoundStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundStreamResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ScanPolygonStreamResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor               string        `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScanPolygonStreamResponse) Reset()         { *m = ScanPolygonStreamResponse{} }
+func (m *ScanPolygonStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPolygonStreamResponse) ProtoMessage()    {}
+func (*ScanPolygonStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *ScanPolygonStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPolygonStreamResponse.Unmarshal(m, b)
+}
+func (m *ScanPolygonStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPolygonStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPolygonStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPolygonStreamResponse.Merge(m, src)
+}
+func (m *ScanPolygonStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPolygonStreamResponse.Size(m)
+}
+func (m *ScanPolygonStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPolygonStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPolygonStreamResponse proto.InternalMessageInfo
+
+func (m *ScanPolygonStreamResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ScanPolygonStreamResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type NearbyRequest struct {
+	Point                *Point          `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	K                    int64           `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	MaxDistance          float64         `protobuf:"fixed64,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Prefix               string          `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string          `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NearbyRequest) Reset()         { *m = NearbyRequest{} }
+func (m *NearbyRequest) String() string { return proto.CompactTextString(m) }
+func (*NearbyRequest) ProtoMessage()    {}
+func (*NearbyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *NearbyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearbyRequest.Unmarshal(m, b)
+}
+func (m *NearbyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearbyRequest.Marshal(b, m, deterministic)
+}
+func (m *NearbyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearbyRequest.Merge(m, src)
+}
+func (m *NearbyRequest) XXX_Size() int {
+	return xxx_messageInfo_NearbyRequest.Size(m)
+}
+func (m *NearbyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearbyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearbyRequest proto.InternalMessageInfo
+
+func (m *NearbyRequest) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *NearbyRequest) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *NearbyRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *NearbyRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+//NearbyObject is an object detail and its distance from the point in a NearbyRequest
+type NearbyObject struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Distance             float64       `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NearbyObject) Reset()         { *m = NearbyObject{} }
+func (m *NearbyObject) String() string { return proto.CompactTextString(m) }
+func (*NearbyObject) ProtoMessage()    {}
+func (*NearbyObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *NearbyObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearbyObject.Unmarshal(m, b)
+}
+func (m *NearbyObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearbyObject.Marshal(b, m, deterministic)
+}
+func (m *NearbyObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearbyObject.Merge(m, src)
+}
+func (m *NearbyObject) XXX_Size() int {
+	return xxx_messageInfo_NearbyObject.Size(m)
+}
+func (m *NearbyObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearbyObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearbyObject proto.InternalMessageInfo
+
+func (m *NearbyObject) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *NearbyObject) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type NearbyResponse struct {
+	Objects              []*NearbyObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NearbyResponse) Reset()         { *m = NearbyResponse{} }
+func (m *NearbyResponse) String() string { return proto.CompactTextString(m) }
+func (*NearbyResponse) ProtoMessage()    {}
+func (*NearbyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *NearbyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearbyResponse.Unmarshal(m, b)
+}
+func (m *NearbyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearbyResponse.Marshal(b, m, deterministic)
+}
+func (m *NearbyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearbyResponse.Merge(m, src)
+}
+func (m *NearbyResponse) XXX_Size() int {
+	return xxx_messageInfo_NearbyResponse.Size(m)
+}
+func (m *NearbyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearbyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearbyResponse proto.InternalMessageInfo
+
+func (m *NearbyResponse) GetObjects() []*NearbyObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type CountRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Polygon              *Polygon        `protobuf:"bytes,2,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Keys                 []string        `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefix               string          `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string          `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	GroupBy              string          `protobuf:"bytes,7,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CountRequest) Reset()         { *m = CountRequest{} }
+func (m *CountRequest) String() string { return proto.CompactTextString(m) }
+func (*CountRequest) ProtoMessage()    {}
+func (*CountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *CountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountRequest.Unmarshal(m, b)
+}
+func (m *CountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountRequest.Marshal(b, m, deterministic)
+}
+func (m *CountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountRequest.Merge(m, src)
+}
+func (m *CountRequest) XXX_Size() int {
+	return xxx_messageInfo_CountRequest.Size(m)
+}
+func (m *CountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountRequest proto.InternalMessageInfo
+
+func (m *CountRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *CountRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *CountRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *CountRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *CountRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *CountRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *CountRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+type Aggregate struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MinUpdatedUnix       int64    `protobuf:"varint,2,opt,name=min_updated_unix,json=minUpdatedUnix,proto3" json:"min_updated_unix,omitempty"`
+	MaxUpdatedUnix       int64    `protobuf:"varint,3,opt,name=max_updated_unix,json=maxUpdatedUnix,proto3" json:"max_updated_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Aggregate.Unmarshal(m, b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return xxx_messageInfo_Aggregate.Size(m)
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Aggregate) GetMinUpdatedUnix() int64 {
+	if m != nil {
+		return m.MinUpdatedUnix
+	}
+	return 0
+}
+
+func (m *Aggregate) GetMaxUpdatedUnix() int64 {
+	if m != nil {
+		return m.MaxUpdatedUnix
+	}
+	return 0
+}
+
+type CountResponse struct {
+	Total                *Aggregate            `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Groups               map[string]*Aggregate `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CountResponse) Reset()         { *m = CountResponse{} }
+func (m *CountResponse) String() string { return proto.CompactTextString(m) }
+func (*CountResponse) ProtoMessage()    {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountResponse.Unmarshal(m, b)
+}
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
+}
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
+}
+func (m *CountResponse) XXX_Size() int {
+	return xxx_messageInfo_CountResponse.Size(m)
+}
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
+
+func (m *CountResponse) GetTotal() *Aggregate {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *CountResponse) GetGroups() map[string]*Aggregate {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type HeatmapRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Precision            int64           `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	Zoom                 int64           `protobuf:"varint,3,opt,name=zoom,proto3" json:"zoom,omitempty"`
+	Prefix               string          `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Centroids            bool            `protobuf:"varint,6,opt,name=centroids,proto3" json:"centroids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HeatmapRequest) Reset()         { *m = HeatmapRequest{} }
+func (m *HeatmapRequest) String() string { return proto.CompactTextString(m) }
+func (*HeatmapRequest) ProtoMessage()    {}
+func (*HeatmapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *HeatmapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeatmapRequest.Unmarshal(m, b)
+}
+func (m *HeatmapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeatmapRequest.Marshal(b, m, deterministic)
+}
+func (m *HeatmapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeatmapRequest.Merge(m, src)
+}
+func (m *HeatmapRequest) XXX_Size() int {
+	return xxx_messageInfo_HeatmapRequest.Size(m)
+}
+func (m *HeatmapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeatmapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeatmapRequest proto.InternalMessageInfo
+
+func (m *HeatmapRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *HeatmapRequest) GetPrecision() int64 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *HeatmapRequest) GetZoom() int64 {
+	if m != nil {
+		return m.Zoom
+	}
+	return 0
+}
+
+func (m *HeatmapRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *HeatmapRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *HeatmapRequest) GetCentroids() bool {
+	if m != nil {
+		return m.Centroids
+	}
+	return false
+}
+
+type HeatmapCell struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Centroid             *Point   `protobuf:"bytes,3,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeatmapCell) Reset()         { *m = HeatmapCell{} }
+func (m *HeatmapCell) String() string { return proto.CompactTextString(m) }
+func (*HeatmapCell) ProtoMessage()    {}
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *HeatmapCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeatmapCell.Unmarshal(m, b)
+}
+func (m *HeatmapCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeatmapCell.Marshal(b, m, deterministic)
+}
+func (m *HeatmapCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeatmapCell.Merge(m, src)
+}
+func (m *HeatmapCell) XXX_Size() int {
+	return xxx_messageInfo_HeatmapCell.Size(m)
+}
+func (m *HeatmapCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeatmapCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeatmapCell proto.InternalMessageInfo
+
+func (m *HeatmapCell) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *HeatmapCell) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *HeatmapCell) GetCentroid() *Point {
+	if m != nil {
+		return m.Centroid
+	}
+	return nil
+}
+
+type HeatmapResponse struct {
+	Cells                []*HeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HeatmapResponse) Reset()         { *m = HeatmapResponse{} }
+func (m *HeatmapResponse) String() string { return proto.CompactTextString(m) }
+func (*HeatmapResponse) ProtoMessage()    {}
+func (*HeatmapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *HeatmapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeatmapResponse.Unmarshal(m, b)
+}
+func (m *HeatmapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeatmapResponse.Marshal(b, m, deterministic)
+}
+func (m *HeatmapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeatmapResponse.Merge(m, src)
+}
+func (m *HeatmapResponse) XXX_Size() int {
+	return xxx_messageInfo_HeatmapResponse.Size(m)
+}
+func (m *HeatmapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeatmapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeatmapResponse proto.InternalMessageInfo
+
+func (m *HeatmapResponse) GetCells() []*HeatmapCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type ExportGeoJSONRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Polygon              *Polygon        `protobuf:"bytes,2,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Keys                 []string        `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefix               string          `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string          `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	TrackerEvents        bool            `protobuf:"varint,7,opt,name=tracker_events,json=trackerEvents,proto3" json:"tracker_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExportGeoJSONRequest) Reset()         { *m = ExportGeoJSONRequest{} }
+func (m *ExportGeoJSONRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportGeoJSONRequest) ProtoMessage()    {}
+func (*ExportGeoJSONRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *ExportGeoJSONRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportGeoJSONRequest.Unmarshal(m, b)
+}
+func (m *ExportGeoJSONRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportGeoJSONRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportGeoJSONRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportGeoJSONRequest.Merge(m, src)
+}
+func (m *ExportGeoJSONRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportGeoJSONRequest.Size(m)
+}
+func (m *ExportGeoJSONRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportGeoJSONRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportGeoJSONRequest proto.InternalMessageInfo
+
+func (m *ExportGeoJSONRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ExportGeoJSONRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ExportGeoJSONRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ExportGeoJSONRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ExportGeoJSONRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ExportGeoJSONRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ExportGeoJSONRequest) GetTrackerEvents() bool {
+	if m != nil {
+		return m.TrackerEvents
+	}
+	return false
+}
+
+type ExportGeoJSONResponse struct {
+	FeatureCollection    string   `protobuf:"bytes,1,opt,name=feature_collection,json=featureCollection,proto3" json:"feature_collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportGeoJSONResponse) Reset()         { *m = ExportGeoJSONResponse{} }
+func (m *ExportGeoJSONResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportGeoJSONResponse) ProtoMessage()    {}
+func (*ExportGeoJSONResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *ExportGeoJSONResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportGeoJSONResponse.Unmarshal(m, b)
+}
+func (m *ExportGeoJSONResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportGeoJSONResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportGeoJSONResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportGeoJSONResponse.Merge(m, src)
+}
+func (m *ExportGeoJSONResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportGeoJSONResponse.Size(m)
+}
+func (m *ExportGeoJSONResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportGeoJSONResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportGeoJSONResponse proto.InternalMessageInfo
+
+func (m *ExportGeoJSONResponse) GetFeatureCollection() string {
+	if m != nil {
+		return m.FeatureCollection
+	}
+	return ""
+}
+
+type ImportGeoJSONRequest struct {
+	FeatureCollection    string   `protobuf:"bytes,1,opt,name=feature_collection,json=featureCollection,proto3" json:"feature_collection,omitempty"`
+	KeyProperty          string   `protobuf:"bytes,2,opt,name=key_property,json=keyProperty,proto3" json:"key_property,omitempty"`
+	RadiusProperty       string   `protobuf:"bytes,3,opt,name=radius_property,json=radiusProperty,proto3" json:"radius_property,omitempty"`
+	DefaultRadius        int64    `protobuf:"varint,4,opt,name=default_radius,json=defaultRadius,proto3" json:"default_radius,omitempty"`
+	MetadataProperties   []string `protobuf:"bytes,5,rep,name=metadata_properties,json=metadataProperties,proto3" json:"metadata_properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportGeoJSONRequest) Reset()         { *m = ImportGeoJSONRequest{} }
+func (m *ImportGeoJSONRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportGeoJSONRequest) ProtoMessage()    {}
+func (*ImportGeoJSONRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *ImportGeoJSONRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGeoJSONRequest.Unmarshal(m, b)
+}
+func (m *ImportGeoJSONRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGeoJSONRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportGeoJSONRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGeoJSONRequest.Merge(m, src)
+}
+func (m *ImportGeoJSONRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportGeoJSONRequest.Size(m)
+}
+func (m *ImportGeoJSONRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGeoJSONRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGeoJSONRequest proto.InternalMessageInfo
+
+func (m *ImportGeoJSONRequest) GetFeatureCollection() string {
+	if m != nil {
+		return m.FeatureCollection
+	}
+	return ""
+}
+
+func (m *ImportGeoJSONRequest) GetKeyProperty() string {
+	if m != nil {
+		return m.KeyProperty
+	}
+	return ""
+}
+
+func (m *ImportGeoJSONRequest) GetRadiusProperty() string {
+	if m != nil {
+		return m.RadiusProperty
+	}
+	return ""
+}
+
+func (m *ImportGeoJSONRequest) GetDefaultRadius() int64 {
+	if m != nil {
+		return m.DefaultRadius
+	}
+	return 0
+}
+
+func (m *ImportGeoJSONRequest) GetMetadataProperties() []string {
+	if m != nil {
+		return m.MetadataProperties
+	}
+	return nil
+}
+
+type ImportGeoJSONResponse struct {
+	Results              []*SetResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ImportGeoJSONResponse) Reset()         { *m = ImportGeoJSONResponse{} }
+func (m *ImportGeoJSONResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportGeoJSONResponse) ProtoMessage()    {}
+func (*ImportGeoJSONResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *ImportGeoJSONResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGeoJSONResponse.Unmarshal(m, b)
+}
+func (m *ImportGeoJSONResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGeoJSONResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportGeoJSONResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGeoJSONResponse.Merge(m, src)
+}
+func (m *ImportGeoJSONResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportGeoJSONResponse.Size(m)
+}
+func (m *ImportGeoJSONResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGeoJSONResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGeoJSONResponse proto.InternalMessageInfo
+
+func (m *ImportGeoJSONResponse) GetResults() []*SetResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ImportCSVRequest struct {
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	//the column mapping is read from the first message of the stream only
+	KeyColumn            string   `protobuf:"bytes,2,opt,name=key_column,json=keyColumn,proto3" json:"key_column,omitempty"`
+	LatColumn            string   `protobuf:"bytes,3,opt,name=lat_column,json=latColumn,proto3" json:"lat_column,omitempty"`
+	LonColumn            string   `protobuf:"bytes,4,opt,name=lon_column,json=lonColumn,proto3" json:"lon_column,omitempty"`
+	RadiusColumn         string   `protobuf:"bytes,5,opt,name=radius_column,json=radiusColumn,proto3" json:"radius_column,omitempty"`
+	ExpiresColumn        string   `protobuf:"bytes,6,opt,name=expires_column,json=expiresColumn,proto3" json:"expires_column,omitempty"`
+	DefaultRadius        int64    `protobuf:"varint,7,opt,name=default_radius,json=defaultRadius,proto3" json:"default_radius,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportCSVRequest) Reset()         { *m = ImportCSVRequest{} }
+func (m *ImportCSVRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCSVRequest) ProtoMessage()    {}
+func (*ImportCSVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *ImportCSVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCSVRequest.Unmarshal(m, b)
+}
+func (m *ImportCSVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCSVRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportCSVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCSVRequest.Merge(m, src)
+}
+func (m *ImportCSVRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCSVRequest.Size(m)
+}
+func (m *ImportCSVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCSVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCSVRequest proto.InternalMessageInfo
+
+func (m *ImportCSVRequest) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *ImportCSVRequest) GetKeyColumn() string {
+	if m != nil {
+		return m.KeyColumn
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetLatColumn() string {
+	if m != nil {
+		return m.LatColumn
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetLonColumn() string {
+	if m != nil {
+		return m.LonColumn
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetRadiusColumn() string {
+	if m != nil {
+		return m.RadiusColumn
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetExpiresColumn() string {
+	if m != nil {
+		return m.ExpiresColumn
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetDefaultRadius() int64 {
+	if m != nil {
+		return m.DefaultRadius
+	}
+	return 0
+}
+
+type CSVRowError struct {
+	Row                  int64    `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CSVRowError) Reset()         { *m = CSVRowError{} }
+func (m *CSVRowError) String() string { return proto.CompactTextString(m) }
+func (*CSVRowError) ProtoMessage()    {}
+func (*CSVRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *CSVRowError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CSVRowError.Unmarshal(m, b)
+}
+func (m *CSVRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CSVRowError.Marshal(b, m, deterministic)
+}
+func (m *CSVRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSVRowError.Merge(m, src)
+}
+func (m *CSVRowError) XXX_Size() int {
+	return xxx_messageInfo_CSVRowError.Size(m)
+}
+func (m *CSVRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSVRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSVRowError proto.InternalMessageInfo
+
+func (m *CSVRowError) GetRow() int64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *CSVRowError) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CSVRowError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportCSVResponse struct {
+	Imported             int64          `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors               []*CSVRowError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Failed               int64          `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportCSVResponse) Reset()         { *m = ImportCSVResponse{} }
+func (m *ImportCSVResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCSVResponse) ProtoMessage()    {}
+func (*ImportCSVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *ImportCSVResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCSVResponse.Unmarshal(m, b)
+}
+func (m *ImportCSVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCSVResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportCSVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCSVResponse.Merge(m, src)
+}
+func (m *ImportCSVResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportCSVResponse.Size(m)
+}
+func (m *ImportCSVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCSVResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCSVResponse proto.InternalMessageInfo
+
+func (m *ImportCSVResponse) GetImported() int64 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportCSVResponse) GetErrors() []*CSVRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *ImportCSVResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+type ImportTraceRequest struct {
+	Key      string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Format   TraceFormat       `protobuf:"varint,2,opt,name=format,proto3,enum=api.TraceFormat" json:"format,omitempty"`
+	Trace    string            `protobuf:"bytes,3,opt,name=trace,proto3" json:"trace,omitempty"`
+	Radius   int64             `protobuf:"varint,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Tracking *ObjectTracking   `protobuf:"bytes,5,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//0 sets the points immediately with their recorded timestamps(history import). otherwise the points are replayed with the time between their timestamps
+	//divided by speed- 1 is real time, 10 is 10x accelerated- & are set with the current time so streams, trackers & geofences fire as they would live
+	Speed                float64  `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTraceRequest) Reset()         { *m = ImportTraceRequest{} }
+func (m *ImportTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTraceRequest) ProtoMessage()    {}
+func (*ImportTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *ImportTraceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTraceRequest.Unmarshal(m, b)
+}
+func (m *ImportTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTraceRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTraceRequest.Merge(m, src)
+}
+func (m *ImportTraceRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportTraceRequest.Size(m)
+}
+func (m *ImportTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTraceRequest proto.InternalMessageInfo
+
+func (m *ImportTraceRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ImportTraceRequest) GetFormat() TraceFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TraceFormat_GPX
+}
+
+func (m *ImportTraceRequest) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+func (m *ImportTraceRequest) GetRadius() int64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *ImportTraceRequest) GetTracking() *ObjectTracking {
+	if m != nil {
+		return m.Tracking
+	}
+	return nil
+}
+
+func (m *ImportTraceRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ImportTraceRequest) GetSpeed() float64 {
+	if m != nil {
+		return m.Speed
+	}
+	return 0
+}
+
+type ImportTraceResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	RecordedUnix         int64         `protobuf:"varint,2,opt,name=recorded_unix,json=recordedUnix,proto3" json:"recorded_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ImportTraceResponse) Reset()         { *m = ImportTraceResponse{} }
+func (m *ImportTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportTraceResponse) ProtoMessage()    {}
+func (*ImportTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *ImportTraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTraceResponse.Unmarshal(m, b)
+}
+func (m *ImportTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTraceResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTraceResponse.Merge(m, src)
+}
+func (m *ImportTraceResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportTraceResponse.Size(m)
+}
+func (m *ImportTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTraceResponse proto.InternalMessageInfo
+
+func (m *ImportTraceResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ImportTraceResponse) GetRecordedUnix() int64 {
+	if m != nil {
+		return m.RecordedUnix
+	}
+	return 0
+}
+
+type GetTrajectoryRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	FromUnix             int64    `protobuf:"varint,2,opt,name=from_unix,json=fromUnix,proto3" json:"from_unix,omitempty"`
+	ToUnix               int64    `protobuf:"varint,3,opt,name=to_unix,json=toUnix,proto3" json:"to_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTrajectoryRequest) Reset()         { *m = GetTrajectoryRequest{} }
+func (m *GetTrajectoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrajectoryRequest) ProtoMessage()    {}
+func (*GetTrajectoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *GetTrajectoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrajectoryRequest.Unmarshal(m, b)
+}
+func (m *GetTrajectoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrajectoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTrajectoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrajectoryRequest.Merge(m, src)
+}
+func (m *GetTrajectoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTrajectoryRequest.Size(m)
+}
+func (m *GetTrajectoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrajectoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrajectoryRequest proto.InternalMessageInfo
+
+func (m *GetTrajectoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetTrajectoryRequest) GetFromUnix() int64 {
+	if m != nil {
+		return m.FromUnix
+	}
+	return 0
+}
+
+func (m *GetTrajectoryRequest) GetToUnix() int64 {
+	if m != nil {
+		return m.ToUnix
+	}
+	return 0
+}
+
+type GetTrajectoryResponse struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetTrajectoryResponse) Reset()         { *m = GetTrajectoryResponse{} }
+func (m *GetTrajectoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTrajectoryResponse) ProtoMessage()    {}
+func (*GetTrajectoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *GetTrajectoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrajectoryResponse.Unmarshal(m, b)
+}
+func (m *GetTrajectoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrajectoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTrajectoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrajectoryResponse.Merge(m, src)
+}
+func (m *GetTrajectoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTrajectoryResponse.Size(m)
+}
+func (m *GetTrajectoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrajectoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrajectoryResponse proto.InternalMessageInfo
+
+func (m *GetTrajectoryResponse) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type GetPointAtRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TimestampUnix        int64    `protobuf:"varint,2,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointAtRequest) Reset()         { *m = GetPointAtRequest{} }
+func (m *GetPointAtRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPointAtRequest) ProtoMessage()    {}
+func (*GetPointAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *GetPointAtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointAtRequest.Unmarshal(m, b)
+}
+func (m *GetPointAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointAtRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPointAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointAtRequest.Merge(m, src)
+}
+func (m *GetPointAtRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPointAtRequest.Size(m)
+}
+func (m *GetPointAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointAtRequest proto.InternalMessageInfo
+
+func (m *GetPointAtRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetPointAtRequest) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+type GetPointAtResponse struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	Interpolated         bool     `protobuf:"varint,2,opt,name=interpolated,proto3" json:"interpolated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointAtResponse) Reset()         { *m = GetPointAtResponse{} }
+func (m *GetPointAtResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPointAtResponse) ProtoMessage()    {}
+func (*GetPointAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *GetPointAtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointAtResponse.Unmarshal(m, b)
+}
+func (m *GetPointAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointAtResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPointAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointAtResponse.Merge(m, src)
+}
+func (m *GetPointAtResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPointAtResponse.Size(m)
+}
+func (m *GetPointAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointAtResponse proto.InternalMessageInfo
+
+func (m *GetPointAtResponse) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *GetPointAtResponse) GetInterpolated() bool {
+	if m != nil {
+		return m.Interpolated
+	}
+	return false
+}
+
+type GetPointRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
+func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPointRequest) ProtoMessage()    {}
+func (*GetPointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+}
+func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointRequest.Merge(m, src)
+}
+func (m *GetPointRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPointRequest.Size(m)
+}
+func (m *GetPointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+
+func (m *GetPointRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetPointResponse struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
+func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPointResponse) ProtoMessage()    {}
+func (*GetPointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+}
+func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointResponse.Merge(m, src)
+}
+func (m *GetPointResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPointResponse.Size(m)
+}
+func (m *GetPointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+
+func (m *GetPointResponse) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	Since                uint64   `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupRequest.Unmarshal(m, b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupRequest.Size(m)
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+
+func (m *BackupRequest) GetSince() uint64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type BackupResponse struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Since                uint64   `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupResponse.Unmarshal(m, b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupResponse.Size(m)
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *BackupResponse) GetSince() uint64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type PingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+type PingResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+
+func (m *PingResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("api.BoundMode", BoundMode_name, BoundMode_value)
+	proto.RegisterEnum("api.Transition", Transition_name, Transition_value)
+	proto.RegisterEnum("api.MetadataOperator", MetadataOperator_name, MetadataOperator_value)
+	proto.RegisterEnum("api.EventType", EventType_name, EventType_value)
+	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
+	proto.RegisterEnum("api.TraceFormat", TraceFormat_name, TraceFormat_value)
 	proto.RegisterType((*Point)(nil), "api.Point")
 	proto.RegisterType((*Bound)(nil), "api.Bound")
+	proto.RegisterType((*Polygon)(nil), "api.Polygon")
+	proto.RegisterType((*PolygonHole)(nil), "api.PolygonHole")
 	proto.RegisterType((*Object)(nil), "api.Object")
 	proto.RegisterMapType((map[string]string)(nil), "api.Object.MetadataEntry")
 	proto.RegisterType((*ObjectTracking)(nil), "api.ObjectTracking")
@@ -1925,14 +5815,49 @@ func init() {
 	proto.RegisterType((*Address)(nil), "api.Address")
 	proto.RegisterType((*TrackerEvent)(nil), "api.TrackerEvent")
 	proto.RegisterType((*ObjectDetail)(nil), "api.ObjectDetail")
+	proto.RegisterType((*ObjectEvent)(nil), "api.ObjectEvent")
+	proto.RegisterType((*MetadataCondition)(nil), "api.MetadataCondition")
+	proto.RegisterType((*MetadataFilter)(nil), "api.MetadataFilter")
+	proto.RegisterType((*Geofence)(nil), "api.Geofence")
+	proto.RegisterMapType((map[string]string)(nil), "api.Geofence.MetadataEntry")
+	proto.RegisterType((*GeofenceEvent)(nil), "api.GeofenceEvent")
+	proto.RegisterType((*CreateMetadataIndexRequest)(nil), "api.CreateMetadataIndexRequest")
+	proto.RegisterType((*CreateMetadataIndexResponse)(nil), "api.CreateMetadataIndexResponse")
+	proto.RegisterType((*DeleteMetadataIndexRequest)(nil), "api.DeleteMetadataIndexRequest")
+	proto.RegisterType((*DeleteMetadataIndexResponse)(nil), "api.DeleteMetadataIndexResponse")
+	proto.RegisterType((*ListMetadataIndexesRequest)(nil), "api.ListMetadataIndexesRequest")
+	proto.RegisterType((*ListMetadataIndexesResponse)(nil), "api.ListMetadataIndexesResponse")
+	proto.RegisterType((*CreateGeofenceRequest)(nil), "api.CreateGeofenceRequest")
+	proto.RegisterType((*CreateGeofenceResponse)(nil), "api.CreateGeofenceResponse")
+	proto.RegisterType((*DeleteGeofenceRequest)(nil), "api.DeleteGeofenceRequest")
+	proto.RegisterType((*DeleteGeofenceResponse)(nil), "api.DeleteGeofenceResponse")
+	proto.RegisterType((*ListGeofencesRequest)(nil), "api.ListGeofencesRequest")
+	proto.RegisterType((*ListGeofencesResponse)(nil), "api.ListGeofencesResponse")
+	proto.RegisterType((*GetGeofenceEventsRequest)(nil), "api.GetGeofenceEventsRequest")
+	proto.RegisterType((*GetGeofenceEventsResponse)(nil), "api.GetGeofenceEventsResponse")
+	proto.RegisterType((*StreamGeofenceRequest)(nil), "api.StreamGeofenceRequest")
+	proto.RegisterType((*StreamGeofenceResponse)(nil), "api.StreamGeofenceResponse")
 	proto.RegisterType((*StreamRequest)(nil), "api.StreamRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.StreamRequest.MetadataEntry")
 	proto.RegisterType((*StreamResponse)(nil), "api.StreamResponse")
 	proto.RegisterType((*StreamRegexRequest)(nil), "api.StreamRegexRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.StreamRegexRequest.MetadataEntry")
 	proto.RegisterType((*StreamRegexResponse)(nil), "api.StreamRegexResponse")
 	proto.RegisterType((*StreamPrefixRequest)(nil), "api.StreamPrefixRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.StreamPrefixRequest.MetadataEntry")
 	proto.RegisterType((*StreamPrefixResponse)(nil), "api.StreamPrefixResponse")
+	proto.RegisterType((*StreamBoundRequest)(nil), "api.StreamBoundRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.StreamBoundRequest.MetadataEntry")
+	proto.RegisterType((*StreamBoundResponse)(nil), "api.StreamBoundResponse")
+	proto.RegisterType((*StreamPolygonRequest)(nil), "api.StreamPolygonRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.StreamPolygonRequest.MetadataEntry")
+	proto.RegisterType((*StreamPolygonResponse)(nil), "api.StreamPolygonResponse")
 	proto.RegisterType((*SetRequest)(nil), "api.SetRequest")
 	proto.RegisterType((*SetResponse)(nil), "api.SetResponse")
+	proto.RegisterType((*SetBatchRequest)(nil), "api.SetBatchRequest")
+	proto.RegisterType((*SetResult)(nil), "api.SetResult")
+	proto.RegisterType((*SetBatchResponse)(nil), "api.SetBatchResponse")
+	proto.RegisterType((*SetStreamResponse)(nil), "api.SetStreamResponse")
 	proto.RegisterType((*GetKeysRequest)(nil), "api.GetKeysRequest")
 	proto.RegisterType((*GetKeysResponse)(nil), "api.GetKeysResponse")
 	proto.RegisterType((*GetPrefixKeysRequest)(nil), "api.GetPrefixKeysRequest")
@@ -1948,6 +5873,9 @@ func init() {
 	proto.RegisterType((*GetPrefixRequest)(nil), "api.GetPrefixRequest")
 	proto.RegisterType((*GetPrefixResponse)(nil), "api.GetPrefixResponse")
 	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetPrefixResponse.ObjectsEntry")
+	proto.RegisterType((*GetStreamResponse)(nil), "api.GetStreamResponse")
+	proto.RegisterType((*GetRegexStreamResponse)(nil), "api.GetRegexStreamResponse")
+	proto.RegisterType((*GetPrefixStreamResponse)(nil), "api.GetPrefixStreamResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "api.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "api.DeleteResponse")
 	proto.RegisterType((*ScanBoundRequest)(nil), "api.ScanBoundRequest")
@@ -1959,759 +5887,2356 @@ func init() {
 	proto.RegisterType((*ScanRegexBoundRequest)(nil), "api.ScanRegexBoundRequest")
 	proto.RegisterType((*ScanRegexBoundResponse)(nil), "api.ScanRegexBoundResponse")
 	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPolygonRequest)(nil), "api.ScanPolygonRequest")
+	proto.RegisterType((*ScanPolygonResponse)(nil), "api.ScanPolygonResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPolygonResponse.ObjectsEntry")
+	proto.RegisterType((*ScanBoundStreamResponse)(nil), "api.ScanBoundStreamResponse")
+	proto.RegisterType((*ScanRegexBoundStreamResponse)(nil), "api.ScanRegexBoundStreamResponse")
+	proto.RegisterType((*ScanPrefixBoundStreamResponse)(nil), "api.ScanPrefixBoundStreamResponse")
+	proto.RegisterType((*ScanPolygonStreamResponse)(nil), "api.ScanPolygonStreamResponse")
+	proto.RegisterType((*NearbyRequest)(nil), "api.NearbyRequest")
+	proto.RegisterType((*NearbyObject)(nil), "api.NearbyObject")
+	proto.RegisterType((*NearbyResponse)(nil), "api.NearbyResponse")
+	proto.RegisterType((*CountRequest)(nil), "api.CountRequest")
+	proto.RegisterType((*Aggregate)(nil), "api.Aggregate")
+	proto.RegisterType((*CountResponse)(nil), "api.CountResponse")
+	proto.RegisterMapType((map[string]*Aggregate)(nil), "api.CountResponse.GroupsEntry")
+	proto.RegisterType((*HeatmapRequest)(nil), "api.HeatmapRequest")
+	proto.RegisterType((*HeatmapCell)(nil), "api.HeatmapCell")
+	proto.RegisterType((*HeatmapResponse)(nil), "api.HeatmapResponse")
+	proto.RegisterType((*ExportGeoJSONRequest)(nil), "api.ExportGeoJSONRequest")
+	proto.RegisterType((*ExportGeoJSONResponse)(nil), "api.ExportGeoJSONResponse")
+	proto.RegisterType((*ImportGeoJSONRequest)(nil), "api.ImportGeoJSONRequest")
+	proto.RegisterType((*ImportGeoJSONResponse)(nil), "api.ImportGeoJSONResponse")
+	proto.RegisterType((*ImportCSVRequest)(nil), "api.ImportCSVRequest")
+	proto.RegisterType((*CSVRowError)(nil), "api.CSVRowError")
+	proto.RegisterType((*ImportCSVResponse)(nil), "api.ImportCSVResponse")
+	proto.RegisterType((*ImportTraceRequest)(nil), "api.ImportTraceRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ImportTraceRequest.MetadataEntry")
+	proto.RegisterType((*ImportTraceResponse)(nil), "api.ImportTraceResponse")
+	proto.RegisterType((*GetTrajectoryRequest)(nil), "api.GetTrajectoryRequest")
+	proto.RegisterType((*GetTrajectoryResponse)(nil), "api.GetTrajectoryResponse")
+	proto.RegisterType((*GetPointAtRequest)(nil), "api.GetPointAtRequest")
+	proto.RegisterType((*GetPointAtResponse)(nil), "api.GetPointAtResponse")
 	proto.RegisterType((*GetPointRequest)(nil), "api.GetPointRequest")
 	proto.RegisterType((*GetPointResponse)(nil), "api.GetPointResponse")
+	proto.RegisterType((*BackupRequest)(nil), "api.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "api.BackupResponse")
 	proto.RegisterType((*PingRequest)(nil), "api.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "api.PingResponse")
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+func init() {
+	proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c)
+}
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 4194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x5d, 0x73, 0x1c, 0x57,
+	0x56, 0xea, 0xf9, 0xd2, 0xcc, 0x19, 0xcd, 0x68, 0x74, 0xf5, 0xe1, 0x71, 0xcb, 0xb1, 0xb4, 0xed,
+	0xc8, 0x56, 0x6c, 0x6c, 0x67, 0x95, 0xb5, 0x93, 0x2c, 0xde, 0x5d, 0xac, 0x8f, 0x4c, 0x94, 0xc4,
+	0xb1, 0xaa, 0xe5, 0x2c, 0x4b, 0x08, 0xd1, 0xb6, 0x67, 0xae, 0xe5, 0x46, 0x33, 0xdd, 0x93, 0xee,
+	0x1e, 0x47, 0x13, 0x58, 0xa8, 0x82, 0x27, 0xaa, 0x78, 0xe1, 0x89, 0x67, 0xa8, 0x4a, 0x11, 0x1e,
+	0xa9, 0xa2, 0x80, 0x2a, 0x28, 0x0a, 0x28, 0x8a, 0xe2, 0x01, 0xf8, 0x0b, 0xae, 0xf2, 0x23, 0x4f,
+	0xbc, 0xb0, 0xaf, 0x50, 0xf7, 0xb3, 0xef, 0xed, 0x0f, 0x79, 0x84, 0xed, 0xa0, 0xd5, 0xd3, 0xdc,
+	0x73, 0x4e, 0x9f, 0x7b, 0xbe, 0xee, 0xd7, 0xb9, 0xe7, 0x0a, 0x6a, 0xce, 0xd0, 0xbd, 0x31, 0x0c,
+	0xfc, 0xc8, 0x47, 0x45, 0x67, 0xe8, 0x9a, 0xb7, 0x0f, 0xdd, 0xe8, 0xf1, 0xe8, 0xe1, 0x8d, 0xae,
+	0x3f, 0xb8, 0x39, 0xf8, 0xd2, 0x8d, 0x8e, 0xfc, 0x2f, 0x6f, 0x1e, 0xfa, 0xd7, 0x29, 0xc5, 0xf5,
+	0x27, 0x4e, 0xdf, 0xed, 0x39, 0x91, 0x1f, 0x84, 0x37, 0xe5, 0x4f, 0xf6, 0xb1, 0x75, 0x0d, 0xca,
+	0x7b, 0xbe, 0xeb, 0x45, 0xa8, 0x05, 0xc5, 0xbe, 0x13, 0xb5, 0x8d, 0x55, 0x63, 0xdd, 0xb0, 0xc9,
+	0x4f, 0x0a, 0xf1, 0xbd, 0x76, 0x81, 0x43, 0x7c, 0xcf, 0x3a, 0x84, 0xf2, 0xa6, 0x3f, 0xf2, 0x7a,
+	0xc8, 0x82, 0x4a, 0x17, 0x7b, 0x11, 0x0e, 0x28, 0x7d, 0x7d, 0x03, 0x6e, 0x10, 0x71, 0x28, 0x23,
+	0x9b, 0x63, 0xd0, 0x12, 0x54, 0x02, 0xa7, 0xe7, 0x8e, 0x42, 0xce, 0x81, 0xb7, 0x90, 0x05, 0xa5,
+	0x81, 0xdf, 0xc3, 0xed, 0xe2, 0xaa, 0xb1, 0xde, 0xdc, 0x68, 0xd2, 0x2f, 0x29, 0xd7, 0x7b, 0x7e,
+	0x0f, 0xdb, 0x14, 0x67, 0xfd, 0x06, 0x4c, 0xef, 0xf9, 0xfd, 0xf1, 0xa1, 0xef, 0xa1, 0xab, 0x50,
+	0x19, 0x12, 0xbe, 0x61, 0xdb, 0x58, 0x2d, 0xea, 0x5d, 0x6d, 0x56, 0x9e, 0x3d, 0x5d, 0x29, 0xfc,
+	0xb4, 0x68, 0x73, 0x0a, 0x74, 0x19, 0xca, 0x8f, 0xfd, 0x3e, 0x26, 0x3d, 0x12, 0xd2, 0x16, 0x27,
+	0xa5, 0x8c, 0xde, 0xf7, 0xfb, 0xd8, 0x66, 0x68, 0xeb, 0x5d, 0xa8, 0x2b, 0xd0, 0xd3, 0x74, 0x61,
+	0x7d, 0x5d, 0x84, 0xca, 0xfd, 0x87, 0xbf, 0x89, 0xbb, 0x11, 0xb2, 0xa0, 0x78, 0x84, 0xc7, 0xd4,
+	0x02, 0xb5, 0xcd, 0xd6, 0xb3, 0xa7, 0x2b, 0x33, 0x00, 0x9f, 0xdf, 0xf8, 0xad, 0xef, 0xfe, 0xd2,
+	0xc6, 0xc6, 0xad, 0x9f, 0xbd, 0x6e, 0x13, 0x24, 0x5a, 0x87, 0x32, 0xfd, 0x90, 0xda, 0x20, 0x83,
+	0xf3, 0xaa, 0x61, 0x33, 0x02, 0x74, 0x51, 0x9a, 0x8b, 0x18, 0xa6, 0xc8, 0xd0, 0xad, 0x29, 0x69,
+	0xb6, 0x9b, 0x50, 0x8d, 0x02, 0xa7, 0x7b, 0xe4, 0x7a, 0x87, 0xed, 0x12, 0x65, 0x36, 0x4f, 0x99,
+	0x31, 0x61, 0x1e, 0x70, 0x94, 0x2d, 0x89, 0xd0, 0x2d, 0xa8, 0x0e, 0x70, 0xe4, 0xf4, 0x9c, 0xc8,
+	0x69, 0x97, 0xa9, 0x5e, 0xe7, 0x95, 0x0f, 0x6e, 0xdc, 0xe3, 0xb8, 0x1d, 0x2f, 0x0a, 0xc6, 0xb6,
+	0x24, 0x45, 0x2b, 0x50, 0x3f, 0xc4, 0xd1, 0x81, 0xd3, 0xeb, 0x05, 0x38, 0x0c, 0xdb, 0x95, 0x55,
+	0x63, 0xbd, 0x6a, 0xc3, 0x21, 0x8e, 0xee, 0x32, 0x08, 0xfa, 0x0e, 0xcc, 0x10, 0x82, 0xc8, 0x1d,
+	0xe0, 0xaf, 0x7c, 0x0f, 0xb7, 0xa7, 0x29, 0x05, 0xf9, 0xe8, 0x01, 0x07, 0x11, 0x12, 0x7c, 0x3c,
+	0x74, 0x03, 0x1c, 0x1e, 0x8c, 0x3c, 0xf7, 0xb8, 0x5d, 0x25, 0x1a, 0xd9, 0x75, 0x0e, 0xfb, 0xc4,
+	0x73, 0x8f, 0x09, 0xc9, 0x68, 0xd8, 0x73, 0x22, 0xdc, 0x63, 0x24, 0x35, 0x46, 0xc2, 0x61, 0x84,
+	0xc4, 0xfc, 0x65, 0x68, 0x68, 0x42, 0xa2, 0x96, 0x62, 0x70, 0x66, 0xde, 0x05, 0x28, 0x3f, 0x71,
+	0xfa, 0x23, 0x4c, 0xcd, 0x5b, 0xb3, 0x59, 0xe3, 0xfb, 0x85, 0x77, 0x0c, 0x2b, 0x80, 0xa6, 0x6e,
+	0x19, 0xf4, 0x26, 0xd4, 0xa3, 0xc0, 0x79, 0x82, 0xfb, 0x07, 0x34, 0xfc, 0x0c, 0x1a, 0x7e, 0xb3,
+	0xd4, 0x24, 0x0f, 0x28, 0x9c, 0xc6, 0x1f, 0x44, 0xf2, 0x37, 0xba, 0xc1, 0x4d, 0x8e, 0x03, 0x11,
+	0x51, 0x28, 0x69, 0x72, 0x1c, 0xd8, 0x92, 0xc6, 0xfa, 0x3b, 0x03, 0x1a, 0x1a, 0x0e, 0xdd, 0x81,
+	0xb9, 0xc8, 0x09, 0x88, 0xb9, 0x7c, 0x0a, 0x3f, 0x38, 0x29, 0x60, 0x66, 0x19, 0x29, 0xe3, 0xf0,
+	0x21, 0x1e, 0xa3, 0x37, 0xa0, 0x45, 0x79, 0x1f, 0xf4, 0xdc, 0x00, 0x77, 0x23, 0xd7, 0xf7, 0xd8,
+	0x58, 0xaa, 0xda, 0xb3, 0x14, 0xbe, 0x2d, 0xc1, 0x68, 0x0d, 0x9a, 0x82, 0x34, 0x8c, 0x1c, 0xaf,
+	0xcb, 0x86, 0x57, 0xd5, 0x6e, 0x70, 0x42, 0x06, 0x44, 0xcb, 0x50, 0x63, 0x64, 0x38, 0x72, 0x68,
+	0x14, 0x55, 0xb9, 0xf8, 0x3b, 0x91, 0x63, 0x3d, 0x06, 0x50, 0x38, 0x5e, 0x81, 0xd9, 0xc7, 0xd1,
+	0xa0, 0xaf, 0xf6, 0xcd, 0x0c, 0xdf, 0x24, 0x60, 0x85, 0xb0, 0x05, 0x45, 0xc2, 0xad, 0x40, 0x1d,
+	0x58, 0xc4, 0x2c, 0x84, 0xb8, 0xa5, 0x89, 0x34, 0x2c, 0x9e, 0x85, 0x61, 0x89, 0x28, 0xd6, 0x1f,
+	0x19, 0x30, 0x2d, 0xc2, 0x69, 0x01, 0xca, 0x61, 0xe4, 0x44, 0x98, 0x73, 0x67, 0x0d, 0xd4, 0x86,
+	0x69, 0x11, 0x81, 0xcc, 0xb5, 0xa2, 0x49, 0x30, 0x5d, 0x7f, 0x44, 0xe2, 0x81, 0x32, 0xae, 0xd9,
+	0xa2, 0x49, 0x04, 0xf9, 0xca, 0x1d, 0x52, 0xb5, 0x6a, 0x36, 0xf9, 0x49, 0xa6, 0x20, 0x8a, 0x1c,
+	0xb7, 0xcb, 0x14, 0xc8, 0x5b, 0x08, 0x41, 0xa9, 0xeb, 0x46, 0x63, 0x1a, 0xdc, 0x35, 0x9b, 0xfe,
+	0xb6, 0xfe, 0xb8, 0x00, 0x33, 0xdc, 0x6d, 0x3b, 0x4f, 0xb0, 0x17, 0xa1, 0x4b, 0x50, 0x61, 0x4e,
+	0xe3, 0x73, 0x5c, 0x5d, 0xf1, 0xbd, 0xcd, 0x51, 0xc8, 0x84, 0xaa, 0xb4, 0x38, 0x9b, 0xe6, 0x64,
+	0x9b, 0xf4, 0xee, 0x7a, 0xa1, 0xdb, 0x13, 0xbe, 0xe0, 0x2d, 0x74, 0x1d, 0x6a, 0xd2, 0xa8, 0x7c,
+	0x28, 0xb3, 0x30, 0x8c, 0x8d, 0x6a, 0xc7, 0x14, 0xd4, 0xb5, 0xee, 0x00, 0x87, 0x91, 0x33, 0x18,
+	0xb2, 0xb1, 0x52, 0xa6, 0x06, 0x6d, 0x48, 0x28, 0x1d, 0x50, 0x37, 0x81, 0x58, 0xd8, 0x0b, 0x5d,
+	0xca, 0xb6, 0xa2, 0x47, 0x37, 0x07, 0xdb, 0x0a, 0x09, 0x71, 0x70, 0xdc, 0x62, 0x8c, 0xa7, 0x29,
+	0xe3, 0x66, 0x0c, 0x26, 0x9c, 0xad, 0xbf, 0x30, 0x60, 0x86, 0xa9, 0xbd, 0x8d, 0x23, 0xc7, 0xed,
+	0x4f, 0x66, 0x99, 0xcb, 0xba, 0x07, 0xeb, 0x1b, 0x33, 0x94, 0x8a, 0xbb, 0x3d, 0xf6, 0xa7, 0x09,
+	0x55, 0x39, 0x95, 0x30, 0x87, 0xca, 0x36, 0x7a, 0x87, 0x47, 0x35, 0x0e, 0x0e, 0x30, 0xf1, 0x49,
+	0xd8, 0x2e, 0xd1, 0x61, 0x38, 0x27, 0xf4, 0x92, 0xde, 0xe2, 0x81, 0xce, 0x5b, 0xa1, 0xf5, 0xaf,
+	0x06, 0xd4, 0x99, 0x40, 0xcc, 0x99, 0x16, 0x94, 0xa2, 0xf1, 0x50, 0x8c, 0x7a, 0xb6, 0xe8, 0x50,
+	0xcc, 0x83, 0xf1, 0x10, 0xdb, 0x14, 0x87, 0xde, 0x90, 0x6a, 0x31, 0x81, 0xe7, 0x14, 0xb5, 0x98,
+	0xe6, 0x52, 0xb9, 0xb4, 0x4f, 0x8a, 0x59, 0x3e, 0x31, 0xa1, 0x1a, 0xe2, 0x2f, 0x46, 0x98, 0x44,
+	0x07, 0x71, 0x74, 0xc9, 0x96, 0x6d, 0x74, 0x05, 0xaa, 0xc3, 0x00, 0x3f, 0x71, 0xfd, 0x51, 0xd8,
+	0x2e, 0xa7, 0xcd, 0x28, 0x91, 0xd6, 0x57, 0x30, 0x27, 0xa6, 0xc1, 0x2d, 0xdf, 0xeb, 0x31, 0xe7,
+	0x5d, 0x86, 0xf2, 0x23, 0x17, 0xf7, 0x7b, 0xb9, 0x93, 0x09, 0x43, 0xa3, 0x35, 0x28, 0xf8, 0x43,
+	0xaa, 0x4f, 0x73, 0x63, 0x91, 0xf2, 0x17, 0xbc, 0xee, 0x0f, 0x71, 0x40, 0xf6, 0x01, 0x76, 0xc1,
+	0xa7, 0x03, 0x85, 0x4e, 0x9d, 0x64, 0xf1, 0x29, 0x92, 0x81, 0xc2, 0x5a, 0xd6, 0xfb, 0xd0, 0x14,
+	0xf4, 0xef, 0xb9, 0x7d, 0xb2, 0xaa, 0xdf, 0x06, 0xe8, 0x0a, 0x29, 0xc4, 0x7a, 0xb9, 0xa4, 0x31,
+	0x96, 0x42, 0xda, 0x0a, 0xa5, 0xf5, 0x5f, 0x06, 0x54, 0x3b, 0xd8, 0x7f, 0x44, 0x75, 0x7f, 0x1d,
+	0x4a, 0x9e, 0x33, 0xc0, 0xb9, 0xc2, 0x53, 0x2c, 0x5a, 0x85, 0xf2, 0x43, 0xb2, 0x2f, 0xd0, 0xd6,
+	0x4e, 0xba, 0x53, 0xb0, 0x19, 0x82, 0xc4, 0xd8, 0x90, 0xad, 0xe3, 0xed, 0xa2, 0x12, 0x63, 0x7c,
+	0x6d, 0xb7, 0x05, 0x12, 0xbd, 0xad, 0x2c, 0x85, 0x2c, 0x82, 0x96, 0x29, 0xa1, 0x10, 0x28, 0x6f,
+	0x31, 0x7c, 0xb1, 0x25, 0xe8, 0x1b, 0x03, 0x1a, 0xa2, 0x07, 0x16, 0x85, 0x26, 0x54, 0x0f, 0x39,
+	0x80, 0xb3, 0x90, 0x6d, 0x65, 0x50, 0x15, 0xf2, 0x07, 0x95, 0x3e, 0xc8, 0x8b, 0xcf, 0x1f, 0xe4,
+	0xe9, 0x40, 0x2d, 0x65, 0x04, 0xaa, 0xb5, 0x0d, 0xe6, 0x56, 0x80, 0x9d, 0x08, 0x0b, 0x6d, 0x77,
+	0xbd, 0x1e, 0x3e, 0xb6, 0x49, 0xac, 0x86, 0xd1, 0xa4, 0xc1, 0x66, 0xbd, 0x06, 0xcb, 0x99, 0x5c,
+	0xc2, 0xa1, 0xef, 0x85, 0xd8, 0xfa, 0x1e, 0x98, 0xdb, 0xb8, 0x8f, 0x73, 0x3a, 0x59, 0x82, 0x0a,
+	0xe5, 0xc2, 0x82, 0xaa, 0x66, 0xf3, 0x16, 0x61, 0x9a, 0xf9, 0x15, 0x67, 0x7a, 0x01, 0xcc, 0x8f,
+	0xdc, 0x30, 0xd2, 0x90, 0x38, 0xe4, 0x4c, 0xad, 0x5b, 0xb0, 0x9c, 0x89, 0x65, 0x1f, 0xe7, 0xf6,
+	0xf9, 0x01, 0x2c, 0x32, 0x45, 0x84, 0xfb, 0x84, 0x90, 0xdf, 0x4d, 0x38, 0xb0, 0xbe, 0xd1, 0xd0,
+	0x02, 0x49, 0x6e, 0xea, 0x24, 0x99, 0xb5, 0x05, 0x4b, 0x49, 0x5e, 0xbc, 0xf7, 0x37, 0x9e, 0xc3,
+	0x4c, 0x61, 0x72, 0x1d, 0x16, 0x99, 0x11, 0x92, 0x02, 0x2d, 0x40, 0x99, 0x8c, 0x15, 0xa1, 0x00,
+	0x6b, 0x58, 0x6d, 0x58, 0x4a, 0x92, 0x73, 0x73, 0x2d, 0xc1, 0x02, 0x31, 0x88, 0x80, 0x4b, 0x43,
+	0x6d, 0xc3, 0x62, 0x02, 0xce, 0x85, 0xbc, 0x06, 0x35, 0x21, 0x85, 0x18, 0xee, 0x09, 0x29, 0x63,
+	0xbc, 0xf5, 0x3b, 0xd0, 0xee, 0xe0, 0x48, 0x8b, 0x79, 0xd1, 0xc3, 0x89, 0xb1, 0xcf, 0x47, 0x55,
+	0x21, 0x1e, 0x55, 0xcb, 0x50, 0x7b, 0x14, 0xf8, 0x03, 0x75, 0x6e, 0xad, 0x12, 0x00, 0x9d, 0x56,
+	0xcf, 0xc1, 0x74, 0xe4, 0xab, 0xd1, 0x5c, 0x89, 0x7c, 0x1a, 0xc6, 0x1d, 0x38, 0x9f, 0xd1, 0x3f,
+	0xd7, 0xe4, 0x2a, 0x54, 0xf8, 0x22, 0x62, 0x28, 0x7b, 0x39, 0x8d, 0xd8, 0xe6, 0x14, 0x24, 0x00,
+	0xf6, 0xa3, 0x00, 0x3b, 0x83, 0xa4, 0xbd, 0x97, 0xa1, 0xd6, 0xed, 0xbb, 0xd8, 0x8b, 0x0e, 0xdc,
+	0x9e, 0x50, 0x83, 0x01, 0x76, 0x7b, 0xb1, 0x33, 0x0a, 0xaa, 0x33, 0x36, 0x61, 0x29, 0xc9, 0x8b,
+	0x4b, 0xb4, 0x0e, 0x65, 0xda, 0x1f, 0xf7, 0x7e, 0x96, 0x40, 0x8c, 0xc0, 0xfa, 0xfd, 0x02, 0x34,
+	0x18, 0x93, 0x89, 0x04, 0x41, 0x50, 0x3a, 0xc2, 0x63, 0x21, 0x07, 0xfd, 0x8d, 0xee, 0x28, 0x73,
+	0x60, 0x91, 0x1a, 0x60, 0x95, 0xf6, 0xa7, 0xb1, 0xcd, 0x3d, 0x15, 0x5c, 0x81, 0xd9, 0x00, 0x87,
+	0xa3, 0x01, 0x3e, 0x48, 0x2c, 0x68, 0x4d, 0x06, 0xde, 0xe7, 0x50, 0xf4, 0x1a, 0x40, 0xe8, 0x7a,
+	0x5d, 0xac, 0xee, 0x54, 0x6a, 0x14, 0xf2, 0xe2, 0x7b, 0xfa, 0x3f, 0x35, 0xa0, 0x29, 0xc4, 0x95,
+	0x63, 0x48, 0xdf, 0x8a, 0x9c, 0xb0, 0x66, 0x8b, 0x2d, 0x40, 0xe1, 0x84, 0x2d, 0xc0, 0x8b, 0xaf,
+	0xeb, 0xd6, 0x9f, 0x14, 0x00, 0x09, 0x21, 0x0f, 0xf1, 0xf1, 0x44, 0xfe, 0xba, 0x0c, 0xe5, 0x80,
+	0x10, 0xb7, 0x0b, 0x79, 0x13, 0x2c, 0x45, 0xa3, 0xbb, 0x29, 0x1f, 0xae, 0x69, 0x3e, 0x8c, 0xfb,
+	0x3b, 0xdb, 0x8e, 0xfc, 0x33, 0x03, 0xe6, 0x35, 0x99, 0xcf, 0xac, 0x37, 0xbf, 0x2e, 0x08, 0x49,
+	0xf7, 0x02, 0xfc, 0xc8, 0x9d, 0xcc, 0x9d, 0xeb, 0x50, 0x19, 0x52, 0xea, 0x5c, 0x7f, 0x72, 0x3c,
+	0xda, 0x4c, 0x39, 0xf4, 0xb2, 0xe2, 0x50, 0xad, 0xcb, 0xb3, 0xed, 0xd1, 0x6f, 0x0c, 0x58, 0xd0,
+	0x85, 0x3e, 0xb3, 0x2e, 0xfd, 0x6b, 0x39, 0x40, 0xd9, 0x5e, 0x72, 0x32, 0x8f, 0xe6, 0x6d, 0x45,
+	0xe3, 0x34, 0x0e, 0x25, 0x90, 0x53, 0x6f, 0x51, 0x99, 0x7a, 0xef, 0xa6, 0xb6, 0x9f, 0xea, 0xb0,
+	0x55, 0xa5, 0x38, 0x8d, 0x93, 0xcb, 0x13, 0x38, 0xb9, 0xf2, 0x8a, 0x86, 0x2d, 0x97, 0xf9, 0xcc,
+	0xfa, 0xf8, 0x1f, 0x0b, 0x32, 0x1c, 0xf9, 0x59, 0x60, 0x12, 0x2f, 0xdf, 0x88, 0x8f, 0x13, 0x85,
+	0xf4, 0x71, 0x42, 0x7a, 0x5a, 0x10, 0x65, 0xfa, 0x7a, 0x2b, 0xe5, 0xeb, 0x2b, 0xea, 0x88, 0xd6,
+	0xa4, 0x39, 0xdb, 0xde, 0xfe, 0x73, 0x03, 0x16, 0x13, 0x52, 0x9f, 0x59, 0x7f, 0xbf, 0x0b, 0xb0,
+	0x8f, 0x23, 0xe1, 0xe4, 0x6b, 0x27, 0xe4, 0x27, 0xa4, 0x17, 0x39, 0x89, 0xf5, 0x0e, 0xd4, 0xe9,
+	0xa7, 0xa7, 0xd6, 0xcd, 0xfa, 0x15, 0x98, 0xdd, 0xc7, 0xd1, 0xa6, 0x13, 0x75, 0x1f, 0x8b, 0x9e,
+	0xaf, 0xc3, 0x34, 0x43, 0x8a, 0x4d, 0x66, 0xba, 0xeb, 0x9f, 0x1a, 0xb6, 0xa0, 0xb1, 0x3e, 0x87,
+	0x1a, 0xeb, 0x7b, 0xd4, 0x8f, 0x32, 0x7c, 0x73, 0x8a, 0x84, 0xc4, 0x02, 0x94, 0x71, 0x10, 0xf8,
+	0x01, 0x4f, 0xa1, 0xb0, 0x86, 0x75, 0x07, 0x5a, 0xb1, 0x84, 0x72, 0xd3, 0x39, 0x1d, 0xd0, 0x0e,
+	0x85, 0x88, 0xcc, 0x29, 0x52, 0x0e, 0x5b, 0xa0, 0xad, 0x43, 0x98, 0xdb, 0xc7, 0x51, 0x62, 0xc3,
+	0x35, 0xf1, 0xe7, 0x44, 0x9f, 0x10, 0x47, 0x22, 0x2f, 0x18, 0x62, 0x76, 0xc4, 0x73, 0xdc, 0x3e,
+	0xee, 0x71, 0x07, 0xf3, 0x96, 0x75, 0x1f, 0x9a, 0x1d, 0x4c, 0x32, 0x9e, 0xf2, 0xb0, 0xb0, 0x06,
+	0xe5, 0xbe, 0x3b, 0x70, 0x99, 0x13, 0x8a, 0x9b, 0xb3, 0xcf, 0x9e, 0xae, 0xd4, 0x5b, 0xff, 0x23,
+	0xfe, 0x0c, 0x9b, 0x61, 0x09, 0xc3, 0xee, 0x28, 0x08, 0xfd, 0x80, 0x47, 0x2f, 0x6f, 0x59, 0xef,
+	0xc1, 0xac, 0x64, 0xc8, 0xe5, 0x16, 0x63, 0xd5, 0x50, 0xc6, 0xea, 0x0a, 0xd4, 0x3d, 0x7c, 0x1c,
+	0x1d, 0x68, 0x3c, 0x80, 0x80, 0xb6, 0x18, 0x9f, 0xdf, 0x85, 0x85, 0x0e, 0x8e, 0xd8, 0x8a, 0xa6,
+	0x8a, 0x17, 0x2f, 0xf0, 0xc6, 0x73, 0x16, 0x78, 0xa9, 0x48, 0x61, 0x42, 0x45, 0x8a, 0x9a, 0x22,
+	0x1f, 0xc1, 0x62, 0x42, 0x80, 0x17, 0x51, 0xe7, 0xb7, 0x61, 0xbe, 0x43, 0xfc, 0x74, 0x88, 0x35,
+	0x6d, 0xe4, 0xee, 0xd3, 0x38, 0x79, 0xf7, 0xf9, 0x82, 0xba, 0x7c, 0x08, 0x0b, 0x7a, 0xef, 0x2f,
+	0xa2, 0xca, 0x1f, 0x1a, 0x00, 0x9d, 0x78, 0xc4, 0x67, 0xf1, 0xb8, 0x46, 0x0e, 0xf7, 0xfd, 0x08,
+	0x07, 0xed, 0x82, 0x72, 0x5d, 0xa2, 0xa7, 0xb3, 0x6c, 0x4e, 0x12, 0xeb, 0x56, 0x9c, 0x50, 0xb7,
+	0x92, 0xa6, 0xdb, 0x5f, 0x19, 0x50, 0xef, 0x28, 0xb3, 0xc8, 0xdb, 0xc9, 0x79, 0xe0, 0x35, 0x7e,
+	0xb6, 0x93, 0x24, 0x7c, 0x18, 0x87, 0x6c, 0xea, 0x17, 0xd4, 0xcf, 0x55, 0xdc, 0xbc, 0x07, 0x33,
+	0xea, 0x97, 0x19, 0xb3, 0xc6, 0x15, 0x75, 0x46, 0xcf, 0x9c, 0x34, 0x94, 0x49, 0xfe, 0x6b, 0x03,
+	0x66, 0x85, 0x57, 0x4e, 0x1b, 0x0f, 0xdf, 0xa6, 0x81, 0xff, 0xc1, 0x80, 0x56, 0x2c, 0x27, 0xb7,
+	0xf2, 0x9d, 0xa4, 0x95, 0xad, 0xd8, 0xca, 0x0a, 0xdd, 0x19, 0x31, 0xf5, 0x37, 0x4c, 0x05, 0xfd,
+	0x1c, 0x31, 0xf9, 0x4c, 0xf2, 0x6d, 0x5a, 0xfb, 0x9f, 0x0c, 0x98, 0x53, 0x44, 0xe5, 0xe6, 0xfe,
+	0x41, 0xd2, 0xdc, 0x97, 0x84, 0xb9, 0x75, 0xc2, 0x33, 0x62, 0xef, 0x1f, 0x53, 0x1d, 0xfe, 0xef,
+	0xf9, 0x82, 0xbc, 0xc5, 0xe5, 0xd7, 0x61, 0x49, 0x44, 0xd8, 0xcb, 0x67, 0xfe, 0x19, 0x9c, 0x93,
+	0xf6, 0x7c, 0xf9, 0xdc, 0x2f, 0x41, 0x83, 0xe5, 0x05, 0x4f, 0x98, 0x37, 0xad, 0x16, 0x34, 0x05,
+	0x11, 0x4f, 0x1a, 0xfe, 0xa5, 0x01, 0xad, 0xfd, 0xae, 0xe3, 0x69, 0xe7, 0x25, 0x99, 0x9d, 0x37,
+	0xf2, 0xb2, 0xf3, 0x59, 0x59, 0xa8, 0x38, 0x8a, 0x8b, 0xa7, 0x88, 0xe2, 0xd2, 0x84, 0x51, 0x5c,
+	0x4e, 0x45, 0xb1, 0x22, 0xf6, 0xc9, 0x51, 0x9c, 0x22, 0x3c, 0x23, 0x51, 0xfc, 0xf7, 0x06, 0x2c,
+	0x11, 0xd9, 0x58, 0x48, 0x9c, 0xd2, 0x03, 0x4b, 0x7a, 0x22, 0x22, 0x63, 0x2e, 0x79, 0xf5, 0x5e,
+	0xf8, 0x0f, 0x03, 0xce, 0xa5, 0x14, 0xe0, 0xbe, 0xd8, 0x4a, 0xfa, 0xe2, 0x0d, 0xe9, 0x8b, 0x0c,
+	0xf2, 0x33, 0xe2, 0x91, 0xbf, 0x25, 0xe7, 0xa2, 0xae, 0xe3, 0xd1, 0x19, 0xe0, 0x94, 0x0e, 0x59,
+	0xd0, 0x12, 0x7d, 0xe9, 0x85, 0xf4, 0xd5, 0xbb, 0xe3, 0xdf, 0x78, 0x3c, 0xa9, 0xd2, 0x73, 0x6f,
+	0x6c, 0x26, 0xbd, 0xb1, 0x2e, 0xbd, 0x91, 0xa6, 0x3e, 0x23, 0xce, 0xf8, 0x67, 0x03, 0x10, 0x0d,
+	0x17, 0xfd, 0x98, 0xaf, 0x9c, 0xe4, 0x8d, 0xd3, 0x9c, 0xe4, 0xff, 0xbf, 0xa6, 0xaa, 0x7f, 0x21,
+	0x99, 0x15, 0x55, 0x0d, 0xee, 0x92, 0x1f, 0x25, 0x5d, 0xb2, 0x16, 0x0f, 0x10, 0x9d, 0xf4, 0x8c,
+	0xf8, 0xe3, 0x33, 0x36, 0xd8, 0x69, 0xa8, 0xbc, 0xfc, 0xf5, 0xcb, 0x81, 0x0b, 0x7a, 0x34, 0xbe,
+	0xfc, 0x2e, 0x1e, 0xc2, 0x6b, 0x89, 0xe9, 0xe7, 0xe5, 0xf7, 0xf1, 0x39, 0x9c, 0x57, 0x3c, 0xf8,
+	0xf2, 0xf9, 0xff, 0xbb, 0x01, 0x8d, 0x8f, 0xb1, 0x13, 0x3c, 0x1c, 0xc7, 0xdb, 0x4c, 0x5e, 0x86,
+	0x66, 0x3c, 0xaf, 0x0c, 0x6d, 0x01, 0x8c, 0x23, 0x7e, 0xc0, 0x13, 0x15, 0x68, 0xc6, 0x11, 0xa9,
+	0xd6, 0x1a, 0x38, 0xc7, 0x7a, 0x71, 0x91, 0x61, 0xd7, 0x07, 0xce, 0xf1, 0xb6, 0x52, 0xed, 0xc2,
+	0xd7, 0x9a, 0x92, 0xb6, 0xd6, 0xc8, 0x29, 0xaf, 0x9c, 0x3d, 0xe5, 0x55, 0x9e, 0x3b, 0xb8, 0xac,
+	0x4f, 0x60, 0x86, 0xa9, 0xc3, 0xac, 0x70, 0x1a, 0x13, 0x9d, 0x50, 0x9f, 0x63, 0xfd, 0x00, 0x9a,
+	0xc2, 0x4a, 0xf2, 0xb2, 0x33, 0x31, 0xdc, 0x18, 0x67, 0xb5, 0xf3, 0x38, 0x79, 0xf3, 0xcc, 0x80,
+	0x99, 0x2d, 0x52, 0x4f, 0x34, 0xf9, 0xf4, 0x7f, 0xf9, 0xc4, 0x04, 0xe3, 0xc9, 0x89, 0xc5, 0x57,
+	0x67, 0x5f, 0x74, 0x1e, 0xaa, 0x87, 0x81, 0x3f, 0x1a, 0x1e, 0x3c, 0x1c, 0xd3, 0x12, 0xa0, 0x9a,
+	0x3d, 0x4d, 0xdb, 0x9b, 0x63, 0x6b, 0x04, 0xb5, 0xbb, 0x87, 0x87, 0x01, 0x3e, 0x74, 0x22, 0x4c,
+	0xba, 0xa2, 0x05, 0x54, 0x2c, 0x2b, 0x63, 0xb3, 0x06, 0x5a, 0x87, 0xd6, 0xc0, 0xf5, 0x0e, 0xb4,
+	0x6a, 0x3e, 0x96, 0xf4, 0x69, 0x0e, 0x5c, 0xef, 0x93, 0xb8, 0xa0, 0x8f, 0x52, 0x3a, 0xc7, 0x3a,
+	0x65, 0x91, 0x53, 0x3a, 0xc7, 0x0a, 0xa5, 0xf5, 0x37, 0x06, 0x34, 0xb8, 0x6d, 0xb9, 0x6b, 0x5e,
+	0x87, 0x72, 0xe4, 0x47, 0x4e, 0x9f, 0x1b, 0x97, 0x65, 0x9d, 0xa4, 0x68, 0x36, 0x43, 0xa2, 0xdb,
+	0x50, 0xa1, 0x92, 0x8b, 0x7a, 0xbd, 0x8b, 0x94, 0x4c, 0xe3, 0x74, 0xa3, 0x43, 0x09, 0xd8, 0x3c,
+	0xc9, 0xa9, 0xcd, 0x5d, 0xa8, 0x2b, 0xe0, 0x8c, 0x49, 0xf0, 0x75, 0x7d, 0x12, 0x4c, 0x75, 0x1f,
+	0xcf, 0x80, 0xff, 0x6d, 0x40, 0xf3, 0x7d, 0xec, 0x44, 0x03, 0x67, 0xa8, 0x8c, 0xbe, 0x9c, 0xc0,
+	0x48, 0xde, 0x1e, 0xdc, 0x84, 0xda, 0x30, 0xc0, 0x5d, 0x37, 0x74, 0x79, 0x88, 0x14, 0x37, 0xe7,
+	0x9e, 0x3d, 0x5d, 0x69, 0x28, 0x4b, 0x49, 0xbb, 0x61, 0xc7, 0x34, 0x68, 0x0d, 0x4a, 0x5f, 0xf9,
+	0xfe, 0xa0, 0x5d, 0xcc, 0xa6, 0x5d, 0xb5, 0x29, 0x3a, 0x37, 0x78, 0xe2, 0x30, 0x29, 0x3f, 0x3f,
+	0x4c, 0x2e, 0x40, 0xad, 0x8b, 0xbd, 0x28, 0xf0, 0xdd, 0x9e, 0xa8, 0x0b, 0x8d, 0x01, 0xd6, 0x01,
+	0xd4, 0xb9, 0xda, 0x5b, 0xb8, 0xdf, 0xa7, 0x25, 0x76, 0xb8, 0xdf, 0xe7, 0x36, 0xa4, 0xbf, 0xe3,
+	0xf8, 0x29, 0xa8, 0xf1, 0x73, 0x19, 0xaa, 0x82, 0x4b, 0xbb, 0xa8, 0x18, 0x88, 0x55, 0x13, 0x4b,
+	0x9c, 0xf5, 0x2e, 0xcc, 0x4a, 0xbb, 0xf2, 0xa0, 0xb8, 0x0c, 0x65, 0xc2, 0x58, 0x8c, 0x56, 0x56,
+	0xef, 0xab, 0x48, 0x61, 0x33, 0xb4, 0xf5, 0x73, 0x03, 0x16, 0x76, 0x8e, 0x87, 0x7e, 0x10, 0x75,
+	0xb0, 0xff, 0xc1, 0xfe, 0xfd, 0x8f, 0x7f, 0xe1, 0x87, 0xec, 0x5a, 0xaa, 0x32, 0x6e, 0x5a, 0xa9,
+	0xf7, 0x94, 0x65, 0x70, 0xef, 0xc1, 0x62, 0x42, 0x6f, 0x6e, 0xb9, 0xeb, 0x80, 0x1e, 0x61, 0x27,
+	0x1a, 0x05, 0xf8, 0xa0, 0xeb, 0xf7, 0xfb, 0xbc, 0x18, 0x91, 0x39, 0x6b, 0x8e, 0x63, 0xb6, 0x24,
+	0xc2, 0xfa, 0xbd, 0x02, 0x2c, 0xec, 0x0e, 0x32, 0x0c, 0x78, 0x2b, 0x9f, 0x0f, 0x8b, 0xed, 0x9f,
+	0x18, 0x19, 0xfc, 0xc8, 0x7a, 0x72, 0x84, 0xc7, 0x07, 0xc3, 0xc0, 0x1f, 0xe2, 0x20, 0x12, 0x95,
+	0x1f, 0xf5, 0x23, 0x3c, 0xde, 0xe3, 0x20, 0x7a, 0x07, 0x42, 0x2b, 0x9f, 0x63, 0x2a, 0x96, 0x4f,
+	0x6c, 0x32, 0xb0, 0x24, 0xbc, 0x0d, 0xcd, 0x1e, 0x7e, 0xe4, 0x8c, 0xfa, 0xd1, 0x01, 0xc3, 0xe4,
+	0xed, 0xc1, 0x1a, 0x9c, 0xcc, 0x16, 0x05, 0xd5, 0xf3, 0xe2, 0xc2, 0x45, 0x74, 0xe1, 0xe2, 0x90,
+	0x96, 0x4a, 0xd7, 0x6c, 0x24, 0x50, 0x7b, 0x12, 0x63, 0xdd, 0x85, 0xc5, 0xdd, 0x41, 0x96, 0x31,
+	0x27, 0x4f, 0xa9, 0xff, 0x41, 0x01, 0x5a, 0x8c, 0xc7, 0xd6, 0xfe, 0x8f, 0x95, 0x1a, 0x9e, 0xee,
+	0xe3, 0x91, 0x77, 0x44, 0xcd, 0x36, 0x63, 0xb3, 0x06, 0xb9, 0xda, 0x21, 0x26, 0xea, 0xfa, 0xfd,
+	0xd1, 0xc0, 0xe3, 0x06, 0xaa, 0x1d, 0xe1, 0xf1, 0x16, 0x05, 0x10, 0x74, 0xdf, 0x89, 0x04, 0x9a,
+	0x59, 0xa6, 0xd6, 0x77, 0x22, 0x05, 0xed, 0x7b, 0x02, 0x5d, 0xe2, 0x68, 0xdf, 0xe3, 0xe8, 0x4b,
+	0xd0, 0xe0, 0xc6, 0xe5, 0x14, 0x2c, 0x12, 0x67, 0x18, 0x90, 0x13, 0xad, 0x41, 0x53, 0x54, 0x71,
+	0x73, 0x2a, 0x56, 0x2f, 0xdb, 0xe0, 0x50, 0x4e, 0x96, 0xb6, 0xff, 0xf4, 0x24, 0xf6, 0xb7, 0x3a,
+	0x50, 0x27, 0x46, 0xf0, 0xbf, 0xdc, 0x21, 0x77, 0x15, 0x64, 0xce, 0x0d, 0xfc, 0x2f, 0xf9, 0xd2,
+	0x42, 0x7e, 0x66, 0x54, 0x05, 0x65, 0xdf, 0x72, 0x7c, 0x01, 0x73, 0x8a, 0x4d, 0xb9, 0x4f, 0x4c,
+	0xa8, 0xba, 0x14, 0x88, 0x7b, 0x9c, 0xa7, 0x6c, 0x93, 0xa4, 0x1b, 0xfd, 0x52, 0x7f, 0x27, 0xa0,
+	0x08, 0x63, 0x73, 0x7c, 0xee, 0x8d, 0xc5, 0xcf, 0x0b, 0x80, 0x58, 0x9f, 0xa4, 0x08, 0x55, 0xa6,
+	0x53, 0x26, 0x7b, 0x11, 0x50, 0x79, 0xe4, 0x07, 0x03, 0x27, 0xe2, 0x77, 0x62, 0x2d, 0x59, 0xcb,
+	0x8a, 0xdf, 0xa3, 0x70, 0x9b, 0xe3, 0xd1, 0x05, 0x28, 0x93, 0xd1, 0xcc, 0xcb, 0x62, 0xe5, 0x70,
+	0x62, 0x40, 0xe5, 0xbd, 0x40, 0xe9, 0xb9, 0xef, 0x05, 0xca, 0x93, 0xbc, 0x17, 0x50, 0x6f, 0xa9,
+	0x2b, 0xca, 0x61, 0x23, 0xad, 0x67, 0xee, 0xbd, 0xe5, 0x25, 0x28, 0x87, 0x43, 0x8c, 0x7b, 0x34,
+	0x02, 0x8c, 0xcd, 0xc6, 0xb3, 0xa7, 0x2b, 0xb5, 0xdd, 0x29, 0xfe, 0x67, 0x33, 0xdc, 0x8b, 0x5d,
+	0x4a, 0x62, 0x98, 0xd7, 0xe4, 0x39, 0xfd, 0xa6, 0x99, 0x84, 0x3e, 0xee, 0xfa, 0x41, 0x4f, 0xdf,
+	0xab, 0xcc, 0x08, 0x20, 0xdd, 0x7f, 0x0c, 0xe9, 0x5d, 0xc5, 0x83, 0xc0, 0x21, 0x9f, 0xf8, 0xc1,
+	0xf8, 0x34, 0x0e, 0xd6, 0x4a, 0xd7, 0x0a, 0xf9, 0xa5, 0x6b, 0x45, 0xad, 0x74, 0xed, 0x87, 0xb0,
+	0x98, 0xe8, 0x91, 0xab, 0xb6, 0x76, 0xd2, 0x95, 0x62, 0xbc, 0x1b, 0x7d, 0xc4, 0x32, 0xb6, 0x64,
+	0xcd, 0xbc, 0x1b, 0x9d, 0x46, 0xdc, 0xeb, 0xa9, 0xdb, 0x57, 0x7d, 0xf7, 0x9f, 0xa8, 0x14, 0xfd,
+	0x14, 0x90, 0xda, 0x0f, 0x17, 0x72, 0x35, 0xf7, 0x7c, 0x21, 0xce, 0x15, 0x16, 0xcc, 0xb8, 0x5e,
+	0x84, 0x83, 0xa1, 0xdf, 0x27, 0xbb, 0x3c, 0xfe, 0x8e, 0x41, 0x83, 0x59, 0xd7, 0xe8, 0x5d, 0x04,
+	0xfb, 0x8c, 0x6b, 0xa0, 0xbc, 0x03, 0x30, 0xb4, 0x77, 0x00, 0xd6, 0xf7, 0xa0, 0x15, 0x13, 0x4f,
+	0x2a, 0x86, 0xb5, 0x06, 0x8d, 0x4d, 0xa7, 0x7b, 0x34, 0x1a, 0x2a, 0x93, 0x2f, 0xbd, 0x2f, 0xa7,
+	0x9f, 0x94, 0x6c, 0xd6, 0xb0, 0xee, 0x40, 0x53, 0x90, 0x71, 0xd6, 0xd9, 0x93, 0xb4, 0xfc, 0xba,
+	0xa0, 0x7e, 0xdd, 0x80, 0xfa, 0x1e, 0x19, 0x5b, 0xac, 0x0b, 0xeb, 0x22, 0xcc, 0xb0, 0x26, 0x67,
+	0xd5, 0x84, 0x82, 0xcf, 0xf8, 0x54, 0xed, 0x82, 0x7f, 0x74, 0x75, 0x03, 0x6a, 0xf2, 0xfd, 0x13,
+	0x9a, 0x25, 0x4f, 0x93, 0x5c, 0x2f, 0xda, 0xa5, 0x6f, 0x05, 0x5a, 0x53, 0x68, 0x01, 0x5a, 0x5b,
+	0x6e, 0xd0, 0xed, 0xe3, 0x70, 0x97, 0xd8, 0x2a, 0xc4, 0xdd, 0xa8, 0x65, 0x5c, 0xfd, 0x3e, 0x40,
+	0x5c, 0xf1, 0x8b, 0xea, 0x30, 0x7d, 0x7f, 0x14, 0xf1, 0x0f, 0x00, 0x2a, 0xfc, 0x63, 0x03, 0xd5,
+	0xa0, 0xbc, 0x43, 0xbe, 0x6a, 0x15, 0x50, 0x15, 0x4a, 0x3b, 0xc7, 0x6e, 0xd4, 0x2a, 0x5e, 0xfd,
+	0x19, 0xb4, 0x92, 0x45, 0xe0, 0x94, 0xf0, 0x8b, 0x91, 0xd3, 0x6f, 0x4d, 0xa1, 0x0a, 0x14, 0x76,
+	0xbd, 0x96, 0x41, 0xf8, 0xec, 0x1c, 0xbb, 0x61, 0x14, 0xb6, 0x0a, 0x44, 0xaa, 0x0e, 0x2d, 0x62,
+	0x0d, 0x1e, 0x3c, 0x76, 0xbc, 0x56, 0x11, 0x2d, 0x01, 0x52, 0x00, 0xf7, 0x03, 0xf6, 0x71, 0x09,
+	0xcd, 0x40, 0xf5, 0x23, 0x1c, 0x86, 0x94, 0xaa, 0x8c, 0xe6, 0x61, 0x56, 0xb4, 0x04, 0x49, 0xe5,
+	0xea, 0xdb, 0x50, 0x93, 0x15, 0x00, 0x68, 0x1a, 0x8a, 0xfb, 0x38, 0x62, 0x52, 0xb3, 0xac, 0x73,
+	0xcb, 0x20, 0xea, 0xec, 0xd0, 0x25, 0xa6, 0xa7, 0xc9, 0xbd, 0x49, 0x75, 0x16, 0x8f, 0x73, 0xea,
+	0x30, 0xbd, 0x1d, 0xb8, 0x4f, 0x5c, 0xef, 0xb0, 0x35, 0x45, 0x1a, 0xbf, 0xea, 0xf4, 0xc9, 0x34,
+	0xd6, 0x32, 0x50, 0x03, 0x6a, 0x9b, 0x6e, 0x77, 0xdc, 0xed, 0x93, 0x66, 0x81, 0xe0, 0xb8, 0xa9,
+	0x5a, 0xc5, 0xab, 0x2b, 0x50, 0x57, 0xa6, 0x5a, 0xd2, 0x7d, 0x67, 0xef, 0x27, 0xad, 0x29, 0xf2,
+	0xe3, 0xc3, 0x7b, 0x1f, 0xb5, 0x8c, 0x8d, 0xff, 0x6c, 0x43, 0xb9, 0x83, 0xfd, 0xed, 0x4d, 0x74,
+	0x1d, 0x4a, 0xc4, 0x6d, 0x88, 0xbf, 0x22, 0x8b, 0x1d, 0x6a, 0xce, 0x29, 0x10, 0x9e, 0x22, 0x9f,
+	0x42, 0x57, 0xa9, 0x26, 0x68, 0x36, 0x5e, 0xfa, 0x19, 0x71, 0x2b, 0x06, 0x48, 0xda, 0x77, 0xa1,
+	0x2a, 0xee, 0xe5, 0xd1, 0x82, 0xc0, 0xab, 0x85, 0x04, 0xe6, 0x62, 0x02, 0x2a, 0x3f, 0x7d, 0x87,
+	0x96, 0x0c, 0xb0, 0x9c, 0x41, 0xba, 0xb3, 0x25, 0x01, 0xd0, 0x93, 0x0a, 0xd6, 0xd4, 0xba, 0x41,
+	0x04, 0xec, 0x48, 0x01, 0x3b, 0x49, 0x01, 0x3b, 0x49, 0x01, 0xc5, 0x1d, 0x07, 0x17, 0x30, 0x71,
+	0x49, 0x68, 0x2e, 0x26, 0xa0, 0xf2, 0xd3, 0x3b, 0x50, 0x93, 0x37, 0x18, 0x68, 0x31, 0x79, 0x43,
+	0xa4, 0x8a, 0x99, 0xba, 0x38, 0x62, 0xea, 0x75, 0x12, 0xea, 0x75, 0x92, 0xea, 0x75, 0xd2, 0xea,
+	0xbd, 0x69, 0xa0, 0x0e, 0x34, 0x85, 0x34, 0xfc, 0xf3, 0x6c, 0xc1, 0x97, 0x35, 0x68, 0x06, 0xa3,
+	0x0f, 0x60, 0x56, 0x4a, 0xc6, 0x39, 0xe5, 0xa8, 0x71, 0x41, 0x07, 0x67, 0xf0, 0xba, 0x0d, 0xd3,
+	0xbc, 0x10, 0x01, 0xcd, 0x0b, 0x62, 0xe5, 0xea, 0xdd, 0x5c, 0xd0, 0x81, 0xd2, 0x0c, 0x3b, 0x30,
+	0xa3, 0xde, 0x95, 0xa3, 0xb6, 0x26, 0xb4, 0xca, 0xe1, 0x7c, 0x06, 0x46, 0xb2, 0x79, 0x1f, 0x1a,
+	0x52, 0x3a, 0xca, 0xe7, 0xbc, 0x2e, 0xb1, 0xca, 0xc8, 0xcc, 0x42, 0x49, 0x4e, 0x6f, 0x89, 0xe1,
+	0x89, 0x58, 0x95, 0xb2, 0x76, 0x8d, 0x64, 0xce, 0x6b, 0x30, 0xf9, 0xd1, 0x2d, 0xa8, 0x70, 0x03,
+	0xa2, 0x74, 0xa9, 0xb1, 0x39, 0xaf, 0xc1, 0x14, 0xa3, 0x6d, 0x43, 0x5d, 0x29, 0x0e, 0x45, 0xe7,
+	0x72, 0x4a, 0x5c, 0xcd, 0x76, 0x1a, 0xa1, 0xc5, 0xc3, 0x8c, 0x5a, 0x90, 0x88, 0xda, 0x79, 0x85,
+	0x95, 0xe6, 0xf9, 0x0c, 0x4c, 0x96, 0x38, 0xec, 0xe9, 0xeb, 0xb9, 0x9c, 0xd2, 0x3d, 0xb3, 0x9d,
+	0x46, 0x68, 0x51, 0xd5, 0xd0, 0x8a, 0xa9, 0xd0, 0xf9, 0xdc, 0xb2, 0x30, 0xd3, 0xcc, 0x42, 0x29,
+	0xbc, 0xee, 0x40, 0x4d, 0x26, 0x59, 0x79, 0x6c, 0x26, 0xaf, 0xe7, 0xcc, 0xa5, 0x24, 0x58, 0x7a,
+	0xe5, 0x43, 0x68, 0xea, 0x49, 0x54, 0x64, 0x66, 0xe6, 0xf9, 0xd5, 0xe1, 0x92, 0x7d, 0x07, 0x60,
+	0x4d, 0xa1, 0x8f, 0x61, 0x36, 0x91, 0x2e, 0x45, 0xcb, 0xd9, 0x77, 0x38, 0xea, 0x90, 0xc9, 0xb9,
+	0xe0, 0xb1, 0xa6, 0xd0, 0x26, 0xd4, 0x95, 0xd4, 0xa8, 0x30, 0x76, 0x2a, 0xc1, 0x6f, 0xb6, 0xd3,
+	0x08, 0xc9, 0xe3, 0x03, 0x26, 0x93, 0x92, 0xbc, 0xcd, 0x33, 0xd2, 0x05, 0x1d, 0x9c, 0x11, 0x8b,
+	0xbf, 0x06, 0x0b, 0x59, 0x19, 0xe7, 0x13, 0x4d, 0xf6, 0x9d, 0x0c, 0x5c, 0x06, 0xeb, 0xcf, 0x60,
+	0x31, 0x61, 0x07, 0xce, 0xfb, 0x44, 0x03, 0x5a, 0x59, 0xc8, 0x0c, 0xee, 0x7b, 0x30, 0xa7, 0x58,
+	0x87, 0x73, 0xce, 0x35, 0xe7, 0xc5, 0x24, 0x22, 0x83, 0xe3, 0x5b, 0x50, 0x61, 0x89, 0x50, 0x3e,
+	0x9a, 0xb5, 0x0c, 0xb3, 0x39, 0xaf, 0xc1, 0xa4, 0x2f, 0xde, 0x84, 0x32, 0xcd, 0xbe, 0xa1, 0x39,
+	0x35, 0x13, 0xc7, 0x3e, 0x41, 0xe9, 0xe4, 0x9c, 0x35, 0x45, 0xa6, 0x4c, 0x9e, 0xc1, 0xe1, 0x53,
+	0xa6, 0x9e, 0x4c, 0x33, 0x17, 0x74, 0xa0, 0x3a, 0xd7, 0x69, 0xa9, 0x0e, 0x3e, 0xc0, 0xb2, 0xd2,
+	0x3e, 0xa6, 0x99, 0x85, 0x52, 0x39, 0xed, 0x0e, 0xd2, 0x9c, 0x76, 0x07, 0xb9, 0x9c, 0x32, 0xd3,
+	0x02, 0xd6, 0x14, 0xfa, 0x21, 0xd4, 0xe4, 0xc9, 0x94, 0xc7, 0x60, 0xf2, 0xf4, 0x6f, 0x2e, 0x25,
+	0xc1, 0xca, 0x92, 0xbd, 0x0d, 0x75, 0xe5, 0xb4, 0xc3, 0xdd, 0x97, 0x3e, 0x8f, 0x99, 0xed, 0x34,
+	0x42, 0x71, 0xdc, 0xa7, 0x30, 0x9f, 0xf1, 0x2c, 0x0b, 0xad, 0x30, 0xf3, 0xe7, 0x3e, 0xfb, 0x32,
+	0x57, 0xf3, 0x09, 0xa4, 0x86, 0x9f, 0xc2, 0x7c, 0xc6, 0xeb, 0x2c, 0xce, 0x3b, 0xff, 0xb5, 0x97,
+	0xb9, 0x9a, 0x4f, 0xa0, 0xf2, 0xce, 0x78, 0xbc, 0xc5, 0x79, 0xe7, 0x3f, 0xfa, 0x32, 0x57, 0xf3,
+	0x09, 0xd4, 0x49, 0x50, 0x7f, 0x95, 0xc5, 0x47, 0x74, 0xe6, 0xb3, 0x2f, 0x73, 0x39, 0x13, 0xa7,
+	0x32, 0xd3, 0x9f, 0x5b, 0x71, 0x66, 0x99, 0x4f, 0xb6, 0xcc, 0xe5, 0x4c, 0x9c, 0x1a, 0x7d, 0xda,
+	0x4b, 0x2c, 0x1e, 0x7d, 0x59, 0xaf, 0xb6, 0x4c, 0x33, 0x0b, 0x25, 0x39, 0x3d, 0xa0, 0x47, 0x42,
+	0xfd, 0x35, 0x14, 0x92, 0x85, 0x68, 0x99, 0xaf, 0xb4, 0xcc, 0x8b, 0x79, 0x68, 0xc9, 0xf5, 0x9e,
+	0x78, 0x83, 0x93, 0x50, 0x36, 0xf3, 0xbd, 0x94, 0xb9, 0x9c, 0x89, 0x53, 0x82, 0x93, 0x6d, 0x51,
+	0xe2, 0x73, 0x6f, 0xbc, 0x45, 0x49, 0x9d, 0xbe, 0x4d, 0x33, 0x0b, 0x25, 0x05, 0xfb, 0x11, 0xad,
+	0x08, 0xe4, 0x27, 0x53, 0x14, 0x6f, 0x31, 0xb5, 0x23, 0xb1, 0x79, 0x2e, 0x05, 0x4f, 0x6c, 0x7a,
+	0xf7, 0xd8, 0x35, 0x98, 0x46, 0x96, 0xda, 0xf4, 0x6a, 0xc7, 0x4e, 0xb6, 0xd3, 0x61, 0xe7, 0x45,
+	0x3e, 0x37, 0x6a, 0x67, 0x4c, 0x73, 0x5e, 0x83, 0xc5, 0xca, 0x6f, 0x96, 0x3f, 0x25, 0xff, 0xbb,
+	0xe3, 0x61, 0x85, 0xfe, 0x2b, 0x8e, 0xb7, 0xfe, 0x77, 0x00, 0xea, 0x47, 0x31, 0x03, 0xd4, 0x43,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GeoDBClient is the client API for GeoDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GeoDBClient interface {
+	//Ping - input: empty, output: returns ok if server is healthy.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	//SetBatch -  input: an array of objects, output: a result(object detail or error) per object in the same order as the input
+	SetBatch(ctx context.Context, in *SetBatchRequest, opts ...grpc.CallOption) (*SetBatchResponse, error)
+	//SetStream -  input: a stream of objects, output: the number of objects that were set & a result(key & error) per object that failed in the order they were received once the client closes the stream
+	SetStream(ctx context.Context, opts ...grpc.CallOption) (GeoDB_SetStreamClient, error)
+	//Get - input: an array of object keys, output: returns an array of current object details
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
+	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
+	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
+	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
+	//GetStream - input: an array of object keys, output: a stream of the current object details. Sends each object as soon as it is found
+	GetStream(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (GeoDB_GetStreamClient, error)
+	//GetRegexStream - input: a regex string, output: a stream of the current object details with keys that match the regex pattern
+	GetRegexStream(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (GeoDB_GetRegexStreamClient, error)
+	//GetPrefixStream - input: a prefix string, output: a stream of the current object details with keys that have the given prefix
+	GetPrefixStream(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (GeoDB_GetPrefixStreamClient, error)
+	//GetKeys -  input: a limit & cursor(optional), output: returns all keys in database in key order
+	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
+	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
+	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
+	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
+	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
+	//Delete -  input: an array of object key strings to delete, output: none
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	//Stream -  input: a clientID(optional) and an array of object keys(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
+	//StreamRegex -  input: a clientID(optional) a regex string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
+	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
+	//StreamPrefix -  input: a clientID(optional) a prefix string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
+	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
+	//StreamBound -  input: a clientID(optional) a geolocation boundary, string-array of unique object ids(optional),
+	//output: a stream of object details for realtime object geolocation updates within the boundary- objects that move out of the boundary are sent once with the Exit event type
+	StreamBound(ctx context.Context, in *StreamBoundRequest, opts ...grpc.CallOption) (GeoDB_StreamBoundClient, error)
+	//StreamPolygon -  input: a clientID(optional) a polygon with optional holes, string-array of unique object ids(optional),
+	//output: a stream of object details for realtime object geolocation updates within the polygon- objects that move out of the polygon are sent once with the Exit event type
+	StreamPolygon(ctx context.Context, in *StreamPolygonRequest, opts ...grpc.CallOption) (GeoDB_StreamPolygonClient, error)
+	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
+	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
+	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
+	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
+	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
+	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
+	//ScanPolygon -  input: a polygon with optional holes, string-array of unique object ids(optional), output: returns an array of current object details that are within the polygon
+	ScanPolygon(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (*ScanPolygonResponse, error)
+	//ScanBoundStream -  input: a geolocation boundary, output: a stream of the current object details that are within the boundary. Sends each object as soon as it is found
+	ScanBoundStream(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanBoundStreamClient, error)
+	//ScanRegexBoundStream -  input: a geolocation boundary & a regex string, output: a stream of the current object details that have keys that match the regex and are within the boundary
+	ScanRegexBoundStream(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoundStreamClient, error)
+	//ScanPrefixBoundStream -  input: a geolocation boundary & a prefix string, output: a stream of the current object details that have keys that match the prefix and are within the boundary
+	ScanPrefixBoundStream(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoundStreamClient, error)
+	//ScanPolygonStream -  input: a polygon with optional holes, output: a stream of the current object details that are within the polygon
+	ScanPolygonStream(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPolygonStreamClient, error)
+	//Nearby -  input: a geolocation, the number of objects to return, a max distance(optional), a prefix or regex(optional),
+	//output: returns an array of the closest object details ordered by their distance from the geolocation
+	Nearby(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*NearbyResponse, error)
+	//Count -  input: a geolocation boundary or polygon(optional), keys, a prefix or regex(optional), a metadata filter(optional) & a metadata field to group by(optional),
+	//output: the number of matching objects & the range of their updated_unix timestamps- in total & per value of the group by field
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	//Heatmap -  input: a geolocation boundary, a geohash precision or map tile zoom level, a prefix(optional) & a metadata filter(optional),
+	//output: the number of objects within the boundary per geohash cell or map tile, and optionally their centroids
+	Heatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*HeatmapResponse, error)
+	//ExportGeoJSON -  input: a geolocation boundary or polygon(optional), keys, a prefix or regex(optional) & a metadata filter(optional),
+	//output: a GeoJSON FeatureCollection of the matching objects with their radius & metadata as feature properties
+	ExportGeoJSON(ctx context.Context, in *ExportGeoJSONRequest, opts ...grpc.CallOption) (*ExportGeoJSONResponse, error)
+	//ImportGeoJSON -  input: a GeoJSON FeatureCollection of points & a mapping of feature properties to object fields,
+	//output: a result(object detail or error) per feature in the same order as the features
+	ImportGeoJSON(ctx context.Context, in *ImportGeoJSONRequest, opts ...grpc.CallOption) (*ImportGeoJSONResponse, error)
+	//ImportCSV -  input: a stream of chunks of a csv file with a header row(id, lat, lon, radius, expires_unix & arbitrary metadata columns),
+	//output: the number of imported & failed rows & an error per row that failed to be imported(up to the first 1000) once the client closes the stream
+	ImportCSV(ctx context.Context, opts ...grpc.CallOption) (GeoDB_ImportCSVClient, error)
+	//ImportTrace -  input: an object key, a GPX or KML document & a replay speed,
+	//output: a stream of the object details as the traces points are set as time-ordered updates of the object
+	ImportTrace(ctx context.Context, in *ImportTraceRequest, opts ...grpc.CallOption) (GeoDB_ImportTraceClient, error)
+	//CreateMetadataIndex -  input: a metadata field, output: none. Filters with Equal or In conditions on indexed fields are served from the index by Get, GetRegex & GetPrefix
+	CreateMetadataIndex(ctx context.Context, in *CreateMetadataIndexRequest, opts ...grpc.CallOption) (*CreateMetadataIndexResponse, error)
+	//DeleteMetadataIndex -  input: an array of metadata fields, output: none
+	DeleteMetadataIndex(ctx context.Context, in *DeleteMetadataIndexRequest, opts ...grpc.CallOption) (*DeleteMetadataIndexResponse, error)
+	//ListMetadataIndexes -  input: none, output: returns all indexed metadata fields
+	ListMetadataIndexes(ctx context.Context, in *ListMetadataIndexesRequest, opts ...grpc.CallOption) (*ListMetadataIndexesResponse, error)
+	//CreateGeofence -  input: a named geofence(circle or polygon), output: the geofence. Objects entering or leaving the geofence produce geofence events- objects that are already inside of it produce an Enter event once it is created
+	CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*CreateGeofenceResponse, error)
+	//DeleteGeofence -  input: an array of geofence names to delete, output: none
+	DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceResponse, error)
+	//ListGeofences -  input: none, output: returns all geofences in the database
+	ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesResponse, error)
+	//GetGeofenceEvents -  input: a geofence name(optional), an object key(optional), a time range(optional), output: returns an array of persisted geofence events ordered by time
+	GetGeofenceEvents(ctx context.Context, in *GetGeofenceEventsRequest, opts ...grpc.CallOption) (*GetGeofenceEventsResponse, error)
+	//StreamGeofence -  input: a clientID(optional) and an array of geofence names(optional),
+	//output: a stream of realtime geofence enter/exit events
+	StreamGeofence(ctx context.Context, in *StreamGeofenceRequest, opts ...grpc.CallOption) (GeoDB_StreamGeofenceClient, error)
+	//GetTrajectory -  input: an object key and a time range(optional), output: returns an array of the objects past locations ordered by time
+	GetTrajectory(ctx context.Context, in *GetTrajectoryRequest, opts ...grpc.CallOption) (*GetTrajectoryResponse, error)
+	//GetPointAt -  input: an object key and a unix timestamp, output: returns the objects location at the given time- interpolated between the closest samples
+	GetPointAt(ctx context.Context, in *GetPointAtRequest, opts ...grpc.CallOption) (*GetPointAtResponse, error)
+	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
+	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+	//Backup -  input: the version of the previous backup(optional), output: a stream of chunks of a consistent full or incremental backup taken while the database is serving traffic.
+	//the backup is restored into a fresh data directory with the geodb restore command
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (GeoDB_BackupClient, error)
+}
+
+type geoDBClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeoDBClient(cc grpc.ClientConnInterface) GeoDBClient {
+	return &geoDBClient{cc}
+}
+
+func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) SetBatch(ctx context.Context, in *SetBatchRequest, opts ...grpc.CallOption) (*SetBatchResponse, error) {
+	out := new(SetBatchResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/SetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) SetStream(ctx context.Context, opts ...grpc.CallOption) (GeoDB_SetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/SetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBSetStreamClient{stream}
+	return x, nil
+}
+
+type GeoDB_SetStreamClient interface {
+	Send(*SetRequest) error
+	CloseAndRecv() (*SetStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBSetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBSetStreamClient) Send(m *SetRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geoDBSetStreamClient) CloseAndRecv() (*SetStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SetStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
+	out := new(GetRegexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
+	out := new(GetPrefixResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetStream(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (GeoDB_GetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/GetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetStreamClient interface {
+	Recv() (*GetStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBGetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetStreamClient) Recv() (*GetStreamResponse, error) {
+	m := new(GetStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetRegexStream(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (GeoDB_GetRegexStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/GetRegexStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetRegexStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetRegexStreamClient interface {
+	Recv() (*GetRegexStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBGetRegexStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetRegexStreamClient) Recv() (*GetRegexStreamResponse, error) {
+	m := new(GetRegexStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetPrefixStream(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (GeoDB_GetPrefixStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[3], "/api.GeoDB/GetPrefixStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetPrefixStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetPrefixStreamClient interface {
+	Recv() (*GetPrefixStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBGetPrefixStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetPrefixStreamClient) Recv() (*GetPrefixStreamResponse, error) {
+	m := new(GetPrefixStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
+	out := new(GetKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
+	out := new(GetRegexKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
+	out := new(GetPrefixKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[4], "/api.GeoDB/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[5], "/api.GeoDB/StreamRegex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamRegexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamRegexClient interface {
+	Recv() (*StreamRegexResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamRegexClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
+	m := new(StreamRegexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[6], "/api.GeoDB/StreamPrefix", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamPrefixClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamPrefixClient interface {
+	Recv() (*StreamPrefixResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamPrefixClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
+	m := new(StreamPrefixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamBound(ctx context.Context, in *StreamBoundRequest, opts ...grpc.CallOption) (GeoDB_StreamBoundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[7], "/api.GeoDB/StreamBound", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamBoundClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamBoundClient interface {
+	Recv() (*StreamBoundResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamBoundClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamBoundClient) Recv() (*StreamBoundResponse, error) {
+	m := new(StreamBoundResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamPolygon(ctx context.Context, in *StreamPolygonRequest, opts ...grpc.CallOption) (GeoDB_StreamPolygonClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[8], "/api.GeoDB/StreamPolygon", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamPolygonClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamPolygonClient interface {
+	Recv() (*StreamPolygonResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamPolygonClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamPolygonClient) Recv() (*StreamPolygonResponse, error) {
+	m := new(StreamPolygonResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
+	out := new(ScanBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
+	out := new(ScanRegexBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
+	out := new(ScanPrefixBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPolygon(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (*ScanPolygonResponse, error) {
+	out := new(ScanPolygonResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanBoundStream(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[9], "/api.GeoDB/ScanBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanBoundStreamClient interface {
+	Recv() (*ScanBoundStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBScanBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanBoundStreamClient) Recv() (*ScanBoundStreamResponse, error) {
+	m := new(ScanBoundStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanRegexBoundStream(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[10], "/api.GeoDB/ScanRegexBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanRegexBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanRegexBoundStreamClient interface {
+	Recv() (*ScanRegexBoundStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBScanRegexBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanRegexBoundStreamClient) Recv() (*ScanRegexBoundStreamResponse, error) {
+	m := new(ScanRegexBoundStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPrefixBoundStream(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[11], "/api.GeoDB/ScanPrefixBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPrefixBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPrefixBoundStreamClient interface {
+	Recv() (*ScanPrefixBoundStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPrefixBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPrefixBoundStreamClient) Recv() (*ScanPrefixBoundStreamResponse, error) {
+	m := new(ScanPrefixBoundStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPolygonStream(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPolygonStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[12], "/api.GeoDB/ScanPolygonStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPolygonStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPolygonStreamClient interface {
+	Recv() (*ScanPolygonStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPolygonStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPolygonStreamClient) Recv() (*ScanPolygonStreamResponse, error) {
+	m := new(ScanPolygonStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) Nearby(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*NearbyResponse, error) {
+	out := new(NearbyResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Nearby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Heatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*HeatmapResponse, error) {
+	out := new(HeatmapResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Heatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ExportGeoJSON(ctx context.Context, in *ExportGeoJSONRequest, opts ...grpc.CallOption) (*ExportGeoJSONResponse, error) {
+	out := new(ExportGeoJSONResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ExportGeoJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ImportGeoJSON(ctx context.Context, in *ImportGeoJSONRequest, opts ...grpc.CallOption) (*ImportGeoJSONResponse, error) {
+	out := new(ImportGeoJSONResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ImportGeoJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ImportCSV(ctx context.Context, opts ...grpc.CallOption) (GeoDB_ImportCSVClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[13], "/api.GeoDB/ImportCSV", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBImportCSVClient{stream}
+	return x, nil
+}
+
+type GeoDB_ImportCSVClient interface {
+	Send(*ImportCSVRequest) error
+	CloseAndRecv() (*ImportCSVResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBImportCSVClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBImportCSVClient) Send(m *ImportCSVRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geoDBImportCSVClient) CloseAndRecv() (*ImportCSVResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCSVResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ImportTrace(ctx context.Context, in *ImportTraceRequest, opts ...grpc.CallOption) (GeoDB_ImportTraceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[14], "/api.GeoDB/ImportTrace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBImportTraceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ImportTraceClient interface {
+	Recv() (*ImportTraceResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBImportTraceClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBImportTraceClient) Recv() (*ImportTraceResponse, error) {
+	m := new(ImportTraceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) CreateMetadataIndex(ctx context.Context, in *CreateMetadataIndexRequest, opts ...grpc.CallOption) (*CreateMetadataIndexResponse, error) {
+	out := new(CreateMetadataIndexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/CreateMetadataIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteMetadataIndex(ctx context.Context, in *DeleteMetadataIndexRequest, opts ...grpc.CallOption) (*DeleteMetadataIndexResponse, error) {
+	out := new(DeleteMetadataIndexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteMetadataIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ListMetadataIndexes(ctx context.Context, in *ListMetadataIndexesRequest, opts ...grpc.CallOption) (*ListMetadataIndexesResponse, error) {
+	out := new(ListMetadataIndexesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ListMetadataIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*CreateGeofenceResponse, error) {
+	out := new(CreateGeofenceResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/CreateGeofence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceResponse, error) {
+	out := new(DeleteGeofenceResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteGeofence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesResponse, error) {
+	out := new(ListGeofencesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ListGeofences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0x13, 0xc7,
-	0x12, 0xd7, 0x5a, 0x96, 0x2c, 0xb5, 0xfe, 0x78, 0x3d, 0xfe, 0x83, 0x2c, 0xde, 0x03, 0xbd, 0xe5,
-	0x01, 0x06, 0x3f, 0xdb, 0x3c, 0x11, 0x08, 0x04, 0x53, 0x05, 0xc2, 0x2e, 0x91, 0xa2, 0x08, 0xd4,
-	0xda, 0xa9, 0x54, 0x52, 0xa9, 0xa8, 0xd6, 0xda, 0x89, 0x98, 0x58, 0xda, 0x55, 0x76, 0x47, 0xc6,
-	0x22, 0x95, 0x0f, 0x91, 0x43, 0xce, 0xa9, 0x1c, 0x72, 0x4a, 0xe5, 0x90, 0x7b, 0xf2, 0x59, 0xa8,
-	0xf0, 0x49, 0x52, 0xf3, 0x67, 0x47, 0x33, 0xb2, 0x50, 0xec, 0x8b, 0x6f, 0x9a, 0xee, 0x5f, 0xf7,
-	0x74, 0xff, 0xa6, 0xbb, 0x67, 0x56, 0x90, 0xf7, 0xfa, 0x64, 0xb3, 0x1f, 0x85, 0x34, 0x44, 0x69,
-	0xaf, 0x4f, 0xaa, 0x77, 0x3b, 0x84, 0xbe, 0x1a, 0x1c, 0x6c, 0xb6, 0xc3, 0xde, 0x56, 0xef, 0x35,
-	0xa1, 0x87, 0xe1, 0xeb, 0xad, 0x4e, 0xb8, 0xc1, 0x11, 0x1b, 0x47, 0x5e, 0x97, 0xf8, 0x1e, 0x0d,
-	0xa3, 0x78, 0x4b, 0xfd, 0x14, 0xc6, 0xce, 0x3a, 0x64, 0x5e, 0x86, 0x24, 0xa0, 0xc8, 0x86, 0x74,
-	0xd7, 0xa3, 0x15, 0xab, 0x66, 0xad, 0x59, 0x2e, 0xfb, 0xc9, 0x25, 0x61, 0x50, 0x99, 0x91, 0x92,
-	0x30, 0x70, 0x9e, 0x40, 0xa6, 0x11, 0x0e, 0x02, 0x1f, 0x39, 0x90, 0x6d, 0xe3, 0x80, 0xe2, 0x88,
-	0xe3, 0x0b, 0x75, 0xd8, 0x64, 0xe1, 0x70, 0x47, 0xae, 0xd4, 0xa0, 0x15, 0xc8, 0x46, 0x9e, 0x4f,
-	0x06, 0xb1, 0xf4, 0x20, 0x57, 0xce, 0x2f, 0x69, 0xc8, 0xbe, 0x38, 0xf8, 0x06, 0xb7, 0x29, 0x72,
-	0x20, 0x7d, 0x88, 0x87, 0xdc, 0x47, 0xbe, 0x61, 0xbf, 0x7b, 0x7b, 0xb9, 0x08, 0xf0, 0xd5, 0xe6,
-	0x77, 0xff, 0xff, 0x5f, 0xbd, 0x7e, 0xe7, 0xfb, 0xff, 0xba, 0x4c, 0x89, 0xd6, 0x20, 0xd3, 0x67,
-	0x7e, 0xb9, 0x17, 0x63, 0xa7, 0x46, 0xf6, 0xdd, 0xdb, 0xcb, 0x33, 0x35, 0xcb, 0x15, 0x00, 0x74,
-	0x49, 0x6d, 0x98, 0xae, 0x59, 0x6b, 0x69, 0xa1, 0xb6, 0x53, 0xc9, 0xc6, 0x68, 0x0b, 0x72, 0x34,
-	0xf2, 0xda, 0x87, 0x24, 0xe8, 0x54, 0x66, 0xb9, 0xb3, 0x45, 0xee, 0x4c, 0x04, 0xb3, 0x2f, 0x55,
-	0xae, 0x02, 0xa1, 0x3b, 0x90, 0xeb, 0x61, 0xea, 0xf9, 0x1e, 0xf5, 0x2a, 0x99, 0x5a, 0x7a, 0xad,
-	0x50, 0x5f, 0xd5, 0x0c, 0x36, 0x9f, 0x4b, 0xdd, 0x6e, 0x40, 0xa3, 0xa1, 0xab, 0xa0, 0xe8, 0x32,
-	0x14, 0x3a, 0x98, 0xb6, 0x3c, 0xdf, 0x8f, 0x70, 0x1c, 0x57, 0xb2, 0x35, 0x6b, 0x2d, 0xe7, 0x42,
-	0x07, 0xd3, 0xc7, 0x42, 0x82, 0xfe, 0x03, 0x45, 0x06, 0xa0, 0xa4, 0x87, 0xdf, 0x84, 0x01, 0xae,
-	0xcc, 0x71, 0x04, 0x33, 0xda, 0x97, 0x22, 0x06, 0xc1, 0xc7, 0x7d, 0x12, 0xe1, 0xb8, 0x35, 0x08,
-	0xc8, 0x71, 0x25, 0xc7, 0x32, 0x72, 0x0b, 0x52, 0xf6, 0x69, 0x40, 0x8e, 0x19, 0x64, 0xd0, 0xf7,
-	0x3d, 0x8a, 0x7d, 0x01, 0xc9, 0x0b, 0x88, 0x94, 0x31, 0x48, 0xf5, 0x01, 0x94, 0x8c, 0x20, 0xd9,
-	0x91, 0x2a, 0xc2, 0x05, 0xbd, 0x4b, 0x90, 0x39, 0xf2, 0xba, 0x03, 0xcc, 0xe9, 0xcd, 0xbb, 0x62,
-	0xf1, 0xd1, 0xcc, 0x3d, 0xcb, 0x89, 0xa0, 0x6c, 0x32, 0x83, 0x6e, 0x41, 0x81, 0x46, 0xde, 0x11,
-	0xee, 0xb6, 0x7a, 0xa1, 0x8f, 0xb9, 0x97, 0x72, 0x7d, 0x9e, 0x53, 0xb2, 0xcf, 0xe5, 0xcf, 0x43,
-	0x1f, 0xbb, 0x40, 0xd5, 0x6f, 0xb4, 0x29, 0x29, 0xc7, 0x11, 0xab, 0x02, 0xc6, 0x20, 0x1a, 0xa7,
-	0x1c, 0x47, 0xae, 0xc2, 0x38, 0x7f, 0x58, 0x50, 0x32, 0x74, 0x68, 0x1b, 0x16, 0xa8, 0x17, 0x31,
-	0xba, 0x42, 0x2e, 0x6f, 0x4d, 0x2b, 0x98, 0x79, 0x01, 0x15, 0x1e, 0x9e, 0xe1, 0x21, 0xba, 0x01,
-	0x36, 0xf7, 0xdd, 0xf2, 0x49, 0x84, 0xdb, 0x94, 0x84, 0x81, 0xa8, 0xc6, 0x9c, 0x3b, 0xcf, 0xe5,
-	0x3b, 0x4a, 0x8c, 0xae, 0x42, 0x39, 0x81, 0xc6, 0xd4, 0x0b, 0xda, 0x98, 0x57, 0x51, 0xce, 0x2d,
-	0x49, 0xa0, 0x10, 0xa2, 0x8b, 0x90, 0x17, 0x30, 0x4c, 0x3d, 0x5e, 0x45, 0x39, 0x19, 0xfe, 0x2e,
-	0xf5, 0x9c, 0x57, 0x00, 0x9a, 0xc7, 0xeb, 0x30, 0xff, 0x8a, 0xf6, 0xba, 0xfa, 0xde, 0x82, 0xf8,
-	0x32, 0x13, 0x6b, 0x40, 0x1b, 0xd2, 0xcc, 0xdb, 0x0c, 0x3f, 0x40, 0xf6, 0x93, 0x95, 0x90, 0x64,
-	0x9a, 0x45, 0x23, 0xea, 0x39, 0x21, 0x96, 0x85, 0xe2, 0xfc, 0x60, 0xc1, 0x5c, 0x52, 0x4e, 0x4b,
-	0x90, 0x89, 0xa9, 0x47, 0xb1, 0xf4, 0x2e, 0x16, 0xa8, 0x02, 0x73, 0x49, 0x05, 0x8a, 0xa3, 0x4d,
-	0x96, 0x4c, 0xd3, 0x0e, 0x07, 0xac, 0x1e, 0xb8, 0xe3, 0xbc, 0x9b, 0x2c, 0x59, 0x20, 0x6f, 0x48,
-	0x9f, 0xa7, 0x95, 0x77, 0xd9, 0x4f, 0xd6, 0xc4, 0x5c, 0x39, 0xac, 0x64, 0xb8, 0x50, 0xae, 0x10,
-	0x82, 0xd9, 0x36, 0xa1, 0x43, 0x5e, 0xdc, 0x79, 0x97, 0xff, 0x76, 0xfe, 0xb4, 0xa0, 0x28, 0x8f,
-	0x6d, 0xf7, 0x08, 0x07, 0x14, 0x5d, 0x81, 0xac, 0x38, 0x34, 0x39, 0x25, 0x0a, 0xda, 0xd9, 0xbb,
-	0x52, 0x85, 0xaa, 0x90, 0x53, 0x8c, 0x8b, 0x41, 0xa1, 0xd6, 0x6c, 0x77, 0x12, 0xc4, 0xc4, 0x4f,
-	0xce, 0x42, 0xae, 0xd0, 0x06, 0xe4, 0x15, 0xa9, 0xb2, 0x95, 0x45, 0x19, 0x8e, 0x48, 0x75, 0x47,
-	0x08, 0x7e, 0xb4, 0xa4, 0x87, 0x63, 0xea, 0xf5, 0xfa, 0xa2, 0x57, 0x32, 0x9c, 0xd0, 0x92, 0x92,
-	0xb2, 0x6e, 0x71, 0x7e, 0xb7, 0xa0, 0x28, 0x82, 0xdb, 0xc1, 0xd4, 0x23, 0xdd, 0xd3, 0xc5, 0x7f,
-	0xcd, 0xe4, 0xb9, 0x50, 0x2f, 0x72, 0x94, 0x3c, 0x9c, 0x11, 0xeb, 0x55, 0xc8, 0xa9, 0x86, 0x17,
-	0xb4, 0xab, 0x35, 0xba, 0x27, 0x6b, 0x0f, 0x47, 0x2d, 0xcc, 0x98, 0x8b, 0x2b, 0xb3, 0xbc, 0x59,
-	0x16, 0x92, 0xde, 0x52, 0x9c, 0xca, 0x72, 0x94, 0xab, 0xd8, 0x79, 0x04, 0xa5, 0x3d, 0x1a, 0x61,
-	0xaf, 0xe7, 0xe2, 0x6f, 0x07, 0x38, 0xa6, 0xac, 0x3e, 0xdb, 0x5d, 0x82, 0x03, 0xda, 0x22, 0xbe,
-	0x2c, 0x88, 0x9c, 0x10, 0x7c, 0xec, 0xb3, 0x53, 0x3b, 0xc4, 0x43, 0xd1, 0x8a, 0x79, 0x97, 0xff,
-	0x76, 0x1e, 0x40, 0x39, 0xf1, 0x10, 0xf7, 0xc3, 0x20, 0xc6, 0xe8, 0xc6, 0x58, 0xda, 0x0b, 0x5a,
-	0xda, 0x82, 0x99, 0x24, 0x79, 0xe7, 0x73, 0x40, 0x89, 0x71, 0x07, 0x1f, 0x9f, 0x2a, 0x86, 0x6b,
-	0x90, 0x89, 0x18, 0x58, 0x54, 0xe5, 0x84, 0x26, 0x16, 0x6a, 0xe7, 0x11, 0x2c, 0x1a, 0xae, 0xcf,
-	0x1e, 0xdc, 0x97, 0x89, 0x87, 0x97, 0x11, 0xfe, 0x9a, 0x9c, 0x2e, 0xba, 0x35, 0xc8, 0xf6, 0x39,
-	0xfa, 0xbd, 0xe1, 0x49, 0xbd, 0xf3, 0x18, 0x96, 0x4c, 0xef, 0x67, 0x0f, 0xf0, 0x3e, 0xc0, 0x1e,
-	0xa6, 0x49, 0x5c, 0xeb, 0x53, 0xaa, 0x4d, 0x5d, 0x75, 0x89, 0xe9, 0x3d, 0x28, 0x70, 0xd3, 0xb3,
-	0x6f, 0x6a, 0x43, 0xb9, 0x89, 0xd9, 0x70, 0x8c, 0xe5, 0xc6, 0xce, 0x55, 0x98, 0x57, 0x12, 0xe9,
-	0x2f, 0x29, 0x14, 0x4b, 0x2b, 0x94, 0x47, 0xb0, 0xd4, 0xc4, 0x54, 0x64, 0xab, 0x99, 0x6b, 0x94,
-	0x59, 0xff, 0x40, 0xd9, 0x3a, 0x2c, 0x8f, 0x79, 0x98, 0xb2, 0xdd, 0x43, 0x58, 0x6c, 0xb2, 0x0c,
-	0x3b, 0xd8, 0xd8, 0x4d, 0x95, 0x8f, 0x35, 0xbd, 0x7c, 0x6e, 0xf2, 0x68, 0x35, 0xf3, 0x29, 0x5b,
-	0xd5, 0x00, 0x9a, 0xa3, 0x73, 0x98, 0x84, 0xf8, 0xd1, 0x82, 0x42, 0x53, 0xe3, 0xfb, 0x43, 0x98,
-	0x13, 0x74, 0x0a, 0x58, 0xa1, 0xfe, 0x6f, 0x4e, 0xb8, 0x06, 0x91, 0xe4, 0xc7, 0xe2, 0x71, 0x90,
-	0xa0, 0xab, 0xcf, 0x93, 0x11, 0x13, 0xbf, 0xef, 0x42, 0xbe, 0xae, 0x5f, 0xc8, 0x13, 0x4f, 0x52,
-	0xbb, 0xa3, 0xef, 0xf3, 0xa3, 0x33, 0x9a, 0xef, 0xb4, 0x04, 0xfd, 0x64, 0x81, 0x3d, 0xb2, 0x95,
-	0x79, 0x6d, 0x8f, 0xe7, 0xe5, 0x8c, 0xf2, 0xd2, 0x70, 0xe7, 0x93, 0xdc, 0x36, 0x0f, 0xd0, 0x6c,
-	0xde, 0xd3, 0x17, 0xdb, 0xcf, 0x16, 0x2c, 0x68, 0xe6, 0x32, 0xc1, 0x87, 0xe3, 0x09, 0x5e, 0x49,
-	0x12, 0x34, 0x81, 0xe7, 0x93, 0xe1, 0x15, 0x28, 0xed, 0xe0, 0x2e, 0xa6, 0x78, 0x5a, 0xed, 0xd9,
-	0x50, 0x4e, 0x40, 0x22, 0x36, 0xe7, 0x29, 0xd8, 0x7b, 0x6d, 0x2f, 0xe0, 0x4f, 0xf1, 0xc4, 0xb2,
-	0x06, 0x99, 0x03, 0xb6, 0x36, 0x1e, 0xe4, 0x02, 0x21, 0x14, 0x13, 0x87, 0x3f, 0x23, 0x49, 0x73,
-	0x35, 0x9d, 0xa4, 0x13, 0xc0, 0xf3, 0x21, 0xc9, 0x85, 0x15, 0xb6, 0xb3, 0x38, 0x9f, 0x33, 0xe6,
-	0xbc, 0x62, 0x8e, 0x73, 0x55, 0x1c, 0xbf, 0x59, 0x70, 0xe1, 0x84, 0x53, 0x99, 0xfd, 0x93, 0xf1,
-	0xec, 0x6f, 0xa8, 0xec, 0x27, 0xc0, 0xcf, 0x87, 0x83, 0x17, 0xb0, 0xcc, 0xf6, 0xe7, 0x4d, 0x78,
-	0x46, 0x0a, 0x96, 0x8c, 0xfb, 0x36, 0xe9, 0xfe, 0x5f, 0x2d, 0xc1, 0xaa, 0xee, 0x51, 0xe6, 0xdf,
-	0x18, 0xcf, 0x7f, 0x4d, 0xe5, 0x7f, 0x12, 0x7d, 0x3e, 0xe9, 0xaf, 0xf3, 0x31, 0x27, 0x3e, 0x2f,
-	0x65, 0xe2, 0xda, 0xf3, 0xd6, 0x32, 0x9e, 0xb7, 0xce, 0x07, 0x62, 0x6c, 0x08, 0xb0, 0xcc, 0xa9,
-	0x96, 0x7c, 0x44, 0x9e, 0xfc, 0x5c, 0x15, 0x0a, 0xa7, 0x04, 0x85, 0x97, 0xec, 0xeb, 0x4f, 0xde,
-	0x89, 0x97, 0xa0, 0x28, 0x96, 0xd2, 0x41, 0x19, 0x66, 0xc2, 0x43, 0x6e, 0x9d, 0x73, 0x67, 0xc2,
-	0xc3, 0x9b, 0x0d, 0x80, 0xd1, 0x27, 0x0f, 0x2a, 0xc0, 0xdc, 0x4e, 0x44, 0x8e, 0x48, 0xd0, 0xb1,
-	0x53, 0x6c, 0xf1, 0x99, 0xd7, 0x65, 0x1f, 0x4c, 0xb6, 0x85, 0x4a, 0x90, 0x6f, 0x90, 0xf6, 0xb0,
-	0xdd, 0x65, 0xcb, 0x19, 0xa6, 0xdb, 0x8f, 0xbc, 0x20, 0x26, 0xd4, 0x4e, 0xd7, 0xff, 0x9a, 0x83,
-	0x4c, 0x13, 0x87, 0x3b, 0x0d, 0xb4, 0x01, 0xb3, 0x6c, 0x37, 0x64, 0x8b, 0xb8, 0x46, 0x71, 0x54,
-	0x17, 0x34, 0x89, 0xec, 0xfe, 0x14, 0xba, 0x09, 0xe9, 0x3d, 0x4c, 0x91, 0x78, 0xf2, 0x8e, 0x5e,
-	0x10, 0x55, 0x7b, 0x24, 0xd0, 0xb1, 0x4d, 0x85, 0x6d, 0x8e, 0x63, 0x9b, 0x06, 0xf6, 0x3e, 0xe4,
-	0x92, 0x49, 0x8f, 0x96, 0xc6, 0x06, 0xbf, 0xb0, 0x5a, 0x9e, 0x78, 0x1d, 0x38, 0x29, 0xb4, 0x0d,
-	0x79, 0x35, 0x43, 0xd1, 0xf2, 0xf8, 0x4c, 0x15, 0xc6, 0x2b, 0x93, 0x47, 0xad, 0x93, 0x42, 0x77,
-	0x61, 0x4e, 0xbe, 0x40, 0xd0, 0x62, 0x02, 0xd2, 0x2e, 0xfd, 0xea, 0x92, 0x29, 0x54, 0x76, 0xbb,
-	0x50, 0xd4, 0x2f, 0x79, 0x54, 0x31, 0xc2, 0xd3, 0x3d, 0xac, 0x4e, 0xd0, 0x28, 0x37, 0x4f, 0xa1,
-	0x64, 0xbc, 0x4b, 0xd0, 0xaa, 0x19, 0xa9, 0xee, 0xa8, 0x3a, 0x49, 0xa5, 0x3c, 0xdd, 0x86, 0xac,
-	0x98, 0xd5, 0x48, 0x7c, 0xe7, 0x1a, 0xd3, 0xbd, 0xba, 0x68, 0xc8, 0x94, 0xd1, 0x1d, 0xc8, 0x8a,
-	0x97, 0xa4, 0x34, 0x32, 0x1e, 0xf4, 0xd2, 0xc8, 0x7c, 0xa2, 0x3b, 0xa9, 0x5b, 0x16, 0xda, 0x81,
-	0x82, 0xf6, 0x40, 0x46, 0x17, 0x0c, 0x9c, 0x76, 0x66, 0x95, 0x93, 0x0a, 0xcd, 0x4b, 0x13, 0x8a,
-	0xfa, 0x33, 0x16, 0xe9, 0x68, 0xf3, 0xf8, 0x56, 0x27, 0x68, 0x34, 0x47, 0xdb, 0x90, 0x57, 0x17,
-	0x84, 0xac, 0x80, 0xf1, 0x4b, 0x4a, 0x56, 0xc0, 0x89, 0x7b, 0xc4, 0x49, 0xa1, 0x67, 0x50, 0x36,
-	0x07, 0x0c, 0xaa, 0x4e, 0x9c, 0x3a, 0xc2, 0xcf, 0xc5, 0x29, 0x13, 0xc9, 0x49, 0xa1, 0x4f, 0x60,
-	0x7e, 0x6c, 0x5a, 0xa3, 0x8b, 0x93, 0x67, 0xb8, 0x70, 0xf7, 0xaf, 0x69, 0x03, 0x5e, 0xf5, 0x85,
-	0xf8, 0x9b, 0x4c, 0x95, 0xa2, 0x3e, 0x8d, 0x46, 0x7d, 0x61, 0x8c, 0x1d, 0x27, 0xd5, 0xc8, 0x7c,
-	0x91, 0xf6, 0xfa, 0xe4, 0x20, 0xcb, 0xff, 0x6c, 0xbb, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xd3, 0x39, 0xf6, 0xb6, 0x13, 0x00, 0x00,
+func (c *geoDBClient) GetGeofenceEvents(ctx context.Context, in *GetGeofenceEventsRequest, opts ...grpc.CallOption) (*GetGeofenceEventsResponse, error) {
+	out := new(GetGeofenceEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetGeofenceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *geoDBClient) StreamGeofence(ctx context.Context, in *StreamGeofenceRequest, opts ...grpc.CallOption) (GeoDB_StreamGeofenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[15], "/api.GeoDB/StreamGeofence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamGeofenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type GeoDB_StreamGeofenceClient interface {
+	Recv() (*StreamGeofenceResponse, error)
+	grpc.ClientStream
+}
 
-// GeoDBClient is the client API for GeoDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GeoDBClient interface {
+type geoDBStreamGeofenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamGeofenceClient) Recv() (*StreamGeofenceResponse, error) {
+	m := new(StreamGeofenceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetTrajectory(ctx context.Context, in *GetTrajectoryRequest, opts ...grpc.CallOption) (*GetTrajectoryResponse, error) {
+	out := new(GetTrajectoryResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetTrajectory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPointAt(ctx context.Context, in *GetPointAtRequest, opts ...grpc.CallOption) (*GetPointAtResponse, error) {
+	out := new(GetPointAtResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPointAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
+	out := new(GetPointResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (GeoDB_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[16], "/api.GeoDB/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GeoDBServer is the server API for GeoDB service.
+type GeoDBServer interface {
 	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	//SetBatch -  input: an array of objects, output: a result(object detail or error) per object in the same order as the input
+	SetBatch(context.Context, *SetBatchRequest) (*SetBatchResponse, error)
+	//SetStream -  input: a stream of objects, output: the number of objects that were set & a result(key & error) per object that failed in the order they were received once the client closes the stream
+	SetStream(GeoDB_SetStreamServer) error
 	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
 	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
+	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
 	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
+	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
+	//GetStream - input: an array of object keys, output: a stream of the current object details. Sends each object as soon as it is found
+	GetStream(*GetRequest, GeoDB_GetStreamServer) error
+	//GetRegexStream - input: a regex string, output: a stream of the current object details with keys that match the regex pattern
+	GetRegexStream(*GetRegexRequest, GeoDB_GetRegexStreamServer) error
+	//GetPrefixStream - input: a prefix string, output: a stream of the current object details with keys that have the given prefix
+	GetPrefixStream(*GetPrefixRequest, GeoDB_GetPrefixStreamServer) error
+	//GetKeys -  input: a limit & cursor(optional), output: returns all keys in database in key order
+	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
 	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
+	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
 	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
+	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
 	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	//Stream -  input: a clientID(optional) and an array of object keys(optional),
 	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
+	Stream(*StreamRequest, GeoDB_StreamServer) error
 	//StreamRegex -  input: a clientID(optional) a regex string,
 	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
+	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
 	//StreamPrefix -  input: a clientID(optional) a prefix string,
 	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
+	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
+	//StreamBound -  input: a clientID(optional) a geolocation boundary, string-array of unique object ids(optional),
+	//output: a stream of object details for realtime object geolocation updates within the boundary- objects that move out of the boundary are sent once with the Exit event type
+	StreamBound(*StreamBoundRequest, GeoDB_StreamBoundServer) error
+	//StreamPolygon -  input: a clientID(optional) a polygon with optional holes, string-array of unique object ids(optional),
+	//output: a stream of object details for realtime object geolocation updates within the polygon- objects that move out of the polygon are sent once with the Exit event type
+	StreamPolygon(*StreamPolygonRequest, GeoDB_StreamPolygonServer) error
 	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
+	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
 	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
+	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
 	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
+	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
+	//ScanPolygon -  input: a polygon with optional holes, string-array of unique object ids(optional), output: returns an array of current object details that are within the polygon
+	ScanPolygon(context.Context, *ScanPolygonRequest) (*ScanPolygonResponse, error)
+	//ScanBoundStream -  input: a geolocation boundary, output: a stream of the current object details that are within the boundary. Sends each object as soon as it is found
+	ScanBoundStream(*ScanBoundRequest, GeoDB_ScanBoundStreamServer) error
+	//ScanRegexBoundStream -  input: a geolocation boundary & a regex string, output: a stream of the current object details that have keys that match the regex and are within the boundary
+	ScanRegexBoundStream(*ScanRegexBoundRequest, GeoDB_ScanRegexBoundStreamServer) error
+	//ScanPrefixBoundStream -  input: a geolocation boundary & a prefix string, output: a stream of the current object details that have keys that match the prefix and are within the boundary
+	ScanPrefixBoundStream(*ScanPrefixBoundRequest, GeoDB_ScanPrefixBoundStreamServer) error
+	//ScanPolygonStream -  input: a polygon with optional holes, output: a stream of the current object details that are within the polygon
+	ScanPolygonStream(*ScanPolygonRequest, GeoDB_ScanPolygonStreamServer) error
+	//Nearby -  input: a geolocation, the number of objects to return, a max distance(optional), a prefix or regex(optional),
+	//output: returns an array of the closest object details ordered by their distance from the geolocation
+	Nearby(context.Context, *NearbyRequest) (*NearbyResponse, error)
+	//Count -  input: a geolocation boundary or polygon(optional), keys, a prefix or regex(optional), a metadata filter(optional) & a metadata field to group by(optional),
+	//output: the number of matching objects & the range of their updated_unix timestamps- in total & per value of the group by field
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	//Heatmap -  input: a geolocation boundary, a geohash precision or map tile zoom level, a prefix(optional) & a metadata filter(optional),
+	//output: the number of objects within the boundary per geohash cell or map tile, and optionally their centroids
+	Heatmap(context.Context, *HeatmapRequest) (*HeatmapResponse, error)
+	//ExportGeoJSON -  input: a geolocation boundary or polygon(optional), keys, a prefix or regex(optional) & a metadata filter(optional),
+	//output: a GeoJSON FeatureCollection of the matching objects with their radius & metadata as feature properties
+	ExportGeoJSON(context.Context, *ExportGeoJSONRequest) (*ExportGeoJSONResponse, error)
+	//ImportGeoJSON -  input: a GeoJSON FeatureCollection of points & a mapping of feature properties to object fields,
+	//output: a result(object detail or error) per feature in the same order as the features
+	ImportGeoJSON(context.Context, *ImportGeoJSONRequest) (*ImportGeoJSONResponse, error)
+	//ImportCSV -  input: a stream of chunks of a csv file with a header row(id, lat, lon, radius, expires_unix & arbitrary metadata columns),
+	//output: the number of imported & failed rows & an error per row that failed to be imported(up to the first 1000) once the client closes the stream
+	ImportCSV(GeoDB_ImportCSVServer) error
+	//ImportTrace -  input: an object key, a GPX or KML document & a replay speed,
+	//output: a stream of the object details as the traces points are set as time-ordered updates of the object
+	ImportTrace(*ImportTraceRequest, GeoDB_ImportTraceServer) error
+	//CreateMetadataIndex -  input: a metadata field, output: none. Filters with Equal or In conditions on indexed fields are served from the index by Get, GetRegex & GetPrefix
+	CreateMetadataIndex(context.Context, *CreateMetadataIndexRequest) (*CreateMetadataIndexResponse, error)
+	//DeleteMetadataIndex -  input: an array of metadata fields, output: none
+	DeleteMetadataIndex(context.Context, *DeleteMetadataIndexRequest) (*DeleteMetadataIndexResponse, error)
+	//ListMetadataIndexes -  input: none, output: returns all indexed metadata fields
+	ListMetadataIndexes(context.Context, *ListMetadataIndexesRequest) (*ListMetadataIndexesResponse, error)
+	//CreateGeofence -  input: a named geofence(circle or polygon), output: the geofence. Objects entering or leaving the geofence produce geofence events- objects that are already inside of it produce an Enter event once it is created
+	CreateGeofence(context.Context, *CreateGeofenceRequest) (*CreateGeofenceResponse, error)
+	//DeleteGeofence -  input: an array of geofence names to delete, output: none
+	DeleteGeofence(context.Context, *DeleteGeofenceRequest) (*DeleteGeofenceResponse, error)
+	//ListGeofences -  input: none, output: returns all geofences in the database
+	ListGeofences(context.Context, *ListGeofencesRequest) (*ListGeofencesResponse, error)
+	//GetGeofenceEvents -  input: a geofence name(optional), an object key(optional), a time range(optional), output: returns an array of persisted geofence events ordered by time
+	GetGeofenceEvents(context.Context, *GetGeofenceEventsRequest) (*GetGeofenceEventsResponse, error)
+	//StreamGeofence -  input: a clientID(optional) and an array of geofence names(optional),
+	//output: a stream of realtime geofence enter/exit events
+	StreamGeofence(*StreamGeofenceRequest, GeoDB_StreamGeofenceServer) error
+	//GetTrajectory -  input: an object key and a time range(optional), output: returns an array of the objects past locations ordered by time
+	GetTrajectory(context.Context, *GetTrajectoryRequest) (*GetTrajectoryResponse, error)
+	//GetPointAt -  input: an object key and a unix timestamp, output: returns the objects location at the given time- interpolated between the closest samples
+	GetPointAt(context.Context, *GetPointAtRequest) (*GetPointAtResponse, error)
 	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+	//Backup -  input: the version of the previous backup(optional), output: a stream of chunks of a consistent full or incremental backup taken while the database is serving traffic.
+	//the backup is restored into a fresh data directory with the geodb restore command
+	Backup(*BackupRequest, GeoDB_BackupServer) error
+}
+
+// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
+type UnimplementedGeoDBServer struct {
+}
+
+func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedGeoDBServer) SetBatch(ctx context.Context, req *SetBatchRequest) (*SetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatch not implemented")
+}
+func (*UnimplementedGeoDBServer) SetStream(srv GeoDB_SetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetStream not implemented")
+}
+func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) GetStream(req *GetRequest, srv GeoDB_GetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStream not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegexStream(req *GetRegexRequest, srv GeoDB_GetRegexStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRegexStream not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefixStream(req *GetPrefixRequest, srv GeoDB_GetPrefixStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPrefixStream not implemented")
+}
+func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamBound(req *StreamBoundRequest, srv GeoDB_StreamBoundServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBound not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamPolygon(req *StreamPolygonRequest, srv GeoDB_StreamPolygonServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPolygon(ctx context.Context, req *ScanPolygonRequest) (*ScanPolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBoundStream(req *ScanBoundRequest, srv GeoDB_ScanBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBoundStream(req *ScanRegexBoundRequest, srv GeoDB_ScanRegexBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanRegexBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBoundStream(req *ScanPrefixBoundRequest, srv GeoDB_ScanPrefixBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPrefixBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPolygonStream(req *ScanPolygonRequest, srv GeoDB_ScanPolygonStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPolygonStream not implemented")
+}
+func (*UnimplementedGeoDBServer) Nearby(ctx context.Context, req *NearbyRequest) (*NearbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nearby not implemented")
+}
+func (*UnimplementedGeoDBServer) Count(ctx context.Context, req *CountRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedGeoDBServer) Heatmap(ctx context.Context, req *HeatmapRequest) (*HeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heatmap not implemented")
+}
+func (*UnimplementedGeoDBServer) ExportGeoJSON(ctx context.Context, req *ExportGeoJSONRequest) (*ExportGeoJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGeoJSON not implemented")
+}
+func (*UnimplementedGeoDBServer) ImportGeoJSON(ctx context.Context, req *ImportGeoJSONRequest) (*ImportGeoJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGeoJSON not implemented")
+}
+func (*UnimplementedGeoDBServer) ImportCSV(srv GeoDB_ImportCSVServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCSV not implemented")
+}
+func (*UnimplementedGeoDBServer) ImportTrace(req *ImportTraceRequest, srv GeoDB_ImportTraceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTrace not implemented")
+}
+func (*UnimplementedGeoDBServer) CreateMetadataIndex(ctx context.Context, req *CreateMetadataIndexRequest) (*CreateMetadataIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMetadataIndex not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteMetadataIndex(ctx context.Context, req *DeleteMetadataIndexRequest) (*DeleteMetadataIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataIndex not implemented")
+}
+func (*UnimplementedGeoDBServer) ListMetadataIndexes(ctx context.Context, req *ListMetadataIndexesRequest) (*ListMetadataIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataIndexes not implemented")
+}
+func (*UnimplementedGeoDBServer) CreateGeofence(ctx context.Context, req *CreateGeofenceRequest) (*CreateGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteGeofence(ctx context.Context, req *DeleteGeofenceRequest) (*DeleteGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) ListGeofences(ctx context.Context, req *ListGeofencesRequest) (*ListGeofencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGeofences not implemented")
+}
+func (*UnimplementedGeoDBServer) GetGeofenceEvents(ctx context.Context, req *GetGeofenceEventsRequest) (*GetGeofenceEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeofenceEvents not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamGeofence(req *StreamGeofenceRequest, srv GeoDB_StreamGeofenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) GetTrajectory(ctx context.Context, req *GetTrajectoryRequest) (*GetTrajectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrajectory not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPointAt(ctx context.Context, req *GetPointAtRequest) (*GetPointAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointAt not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+}
+func (*UnimplementedGeoDBServer) Backup(req *BackupRequest, srv GeoDB_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+
+func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
+	s.RegisterService(&_GeoDB_serviceDesc, srv)
+}
+
+func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_SetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).SetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/SetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).SetBatch(ctx, req.(*SetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type geoDBClient struct {
-	cc *grpc.ClientConn
+func _GeoDB_SetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoDBServer).SetStream(&geoDBSetStreamServer{stream})
 }
 
-func NewGeoDBClient(cc *grpc.ClientConn) GeoDBClient {
-	return &geoDBClient{cc}
+type GeoDB_SetStreamServer interface {
+	SendAndClose(*SetStreamResponse) error
+	Recv() (*SetRequest, error)
+	grpc.ServerStream
 }
 
-func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
-	if err != nil {
+type geoDBSetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBSetStreamServer) SendAndClose(m *SetStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *geoDBSetStreamServer) Recv() (*SetRequest, error) {
+	m := new(SetRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
-	if err != nil {
+func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
-	if err != nil {
+func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
-	out := new(GetRegexResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
-	if err != nil {
+func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
-	out := new(GetPrefixResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _GeoDB_GetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).GetStream(m, &geoDBGetStreamServer{stream})
 }
 
-func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
-	out := new(GetKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GeoDB_GetStreamServer interface {
+	Send(*GetStreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBGetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBGetStreamServer) Send(m *GetStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_GetRegexStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRegexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).GetRegexStream(m, &geoDBGetRegexStreamServer{stream})
 }
 
-func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
-	out := new(GetRegexKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GeoDB_GetRegexStreamServer interface {
+	Send(*GetRegexStreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBGetRegexStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBGetRegexStreamServer) Send(m *GetRegexStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_GetPrefixStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPrefixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).GetPrefixStream(m, &geoDBGetPrefixStreamServer{stream})
 }
 
-func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
-	out := new(GetPrefixKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
-	if err != nil {
+type GeoDB_GetPrefixStreamServer interface {
+	Send(*GetPrefixStreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBGetPrefixStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBGetPrefixStreamServer) Send(m *GetPrefixStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
-	if err != nil {
+func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegexKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/Stream", opts...)
-	if err != nil {
+func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &geoDBStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefixKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
+}
+
+type GeoDB_StreamServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRegexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
+}
+
+type GeoDB_StreamRegexServer interface {
+	Send(*StreamRegexResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamRegexServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPrefixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
+}
+
+type GeoDB_StreamPrefixServer interface {
+	Send(*StreamPrefixResponse) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
+type geoDBStreamPrefixServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamClient struct {
-	grpc.ClientStream
+func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func _GeoDB_StreamBound_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(GeoDBServer).StreamBound(m, &geoDBStreamBoundServer{stream})
 }
 
-func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/StreamRegex", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamRegexClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GeoDB_StreamBoundServer interface {
+	Send(*StreamBoundResponse) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamRegexClient interface {
-	Recv() (*StreamRegexResponse, error)
-	grpc.ClientStream
+type geoDBStreamBoundServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamRegexClient struct {
-	grpc.ClientStream
+func (x *geoDBStreamBoundServer) Send(m *StreamBoundResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
-	m := new(StreamRegexResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func _GeoDB_StreamPolygon_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPolygonRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(GeoDBServer).StreamPolygon(m, &geoDBStreamPolygonServer{stream})
 }
 
-func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/StreamPrefix", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamPrefixClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GeoDB_StreamPolygonServer interface {
+	Send(*StreamPolygonResponse) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamPrefixClient interface {
-	Recv() (*StreamPrefixResponse, error)
-	grpc.ClientStream
+type geoDBStreamPolygonServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamPrefixClient struct {
-	grpc.ClientStream
+func (x *geoDBStreamPolygonServer) Send(m *StreamPolygonResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
-	m := new(StreamPrefixResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
-	out := new(ScanBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegexBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanRegexBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
-	out := new(ScanRegexBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPrefixBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPrefixBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
-	out := new(ScanPrefixBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPolygonRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPolygon(ctx, req.(*ScanPolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
-	out := new(GetPointResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _GeoDB_ScanBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).ScanBoundStream(m, &geoDBScanBoundStreamServer{stream})
 }
 
-// GeoDBServer is the server API for GeoDB service.
-type GeoDBServer interface {
-	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
-	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
-	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
-	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
-	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	//Stream -  input: a clientID(optional) and an array of object keys(optional),
-	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(*StreamRequest, GeoDB_StreamServer) error
-	//StreamRegex -  input: a clientID(optional) a regex string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
-	//StreamPrefix -  input: a clientID(optional) a prefix string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
-	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
-	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
-	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
-	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+type GeoDB_ScanBoundStreamServer interface {
+	Send(*ScanBoundStreamResponse) error
+	grpc.ServerStream
 }
 
-// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
-type UnimplementedGeoDBServer struct {
+type geoDBScanBoundStreamServer struct {
+	grpc.ServerStream
 }
 
-func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
-}
-func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+func (x *geoDBScanBoundStreamServer) Send(m *ScanBoundStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+
+func _GeoDB_ScanRegexBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRegexBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanRegexBoundStream(m, &geoDBScanRegexBoundStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+
+type GeoDB_ScanRegexBoundStreamServer interface {
+	Send(*ScanRegexBoundStreamResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+
+type geoDBScanRegexBoundStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+
+func (x *geoDBScanRegexBoundStreamServer) Send(m *ScanRegexBoundStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _GeoDB_ScanPrefixBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPrefixBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanPrefixBoundStream(m, &geoDBScanPrefixBoundStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+
+type GeoDB_ScanPrefixBoundStreamServer interface {
+	Send(*ScanPrefixBoundStreamResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+
+type geoDBScanPrefixBoundStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+
+func (x *geoDBScanPrefixBoundStreamServer) Send(m *ScanPrefixBoundStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+
+func _GeoDB_ScanPolygonStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPolygonRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanPolygonStream(m, &geoDBScanPolygonStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+
+type GeoDB_ScanPolygonStreamServer interface {
+	Send(*ScanPolygonStreamResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+
+type geoDBScanPolygonStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+
+func (x *geoDBScanPolygonStreamServer) Send(m *ScanPolygonStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
-	s.RegisterService(&_GeoDB_serviceDesc, srv)
+func _GeoDB_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Nearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Nearby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Nearby(ctx, req.(*NearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _GeoDB_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Ping(ctx, in)
+		return srv.(GeoDBServer).Count(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Ping",
+		FullMethod: "/api.GeoDB/Count",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+		return srv.(GeoDBServer).Count(ctx, req.(*CountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
+func _GeoDB_Heatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeatmapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Set(ctx, in)
+		return srv.(GeoDBServer).Heatmap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Set",
+		FullMethod: "/api.GeoDB/Heatmap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+		return srv.(GeoDBServer).Heatmap(ctx, req.(*HeatmapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _GeoDB_ExportGeoJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGeoJSONRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Get(ctx, in)
+		return srv.(GeoDBServer).ExportGeoJSON(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Get",
+		FullMethod: "/api.GeoDB/ExportGeoJSON",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+		return srv.(GeoDBServer).ExportGeoJSON(ctx, req.(*ExportGeoJSONRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexRequest)
+func _GeoDB_ImportGeoJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGeoJSONRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegex(ctx, in)
+		return srv.(GeoDBServer).ImportGeoJSON(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegex",
+		FullMethod: "/api.GeoDB/ImportGeoJSON",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+		return srv.(GeoDBServer).ImportGeoJSON(ctx, req.(*ImportGeoJSONRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixRequest)
+func _GeoDB_ImportCSV_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoDBServer).ImportCSV(&geoDBImportCSVServer{stream})
+}
+
+type GeoDB_ImportCSVServer interface {
+	SendAndClose(*ImportCSVResponse) error
+	Recv() (*ImportCSVRequest, error)
+	grpc.ServerStream
+}
+
+type geoDBImportCSVServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBImportCSVServer) SendAndClose(m *ImportCSVResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *geoDBImportCSVServer) Recv() (*ImportCSVRequest, error) {
+	m := new(ImportCSVRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GeoDB_ImportTrace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportTraceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ImportTrace(m, &geoDBImportTraceServer{stream})
+}
+
+type GeoDB_ImportTraceServer interface {
+	Send(*ImportTraceResponse) error
+	grpc.ServerStream
+}
+
+type geoDBImportTraceServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBImportTraceServer) Send(m *ImportTraceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_CreateMetadataIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMetadataIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefix(ctx, in)
+		return srv.(GeoDBServer).CreateMetadataIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefix",
+		FullMethod: "/api.GeoDB/CreateMetadataIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+		return srv.(GeoDBServer).CreateMetadataIndex(ctx, req.(*CreateMetadataIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKeysRequest)
+func _GeoDB_DeleteMetadataIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetKeys(ctx, in)
+		return srv.(GeoDBServer).DeleteMetadataIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetKeys",
+		FullMethod: "/api.GeoDB/DeleteMetadataIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+		return srv.(GeoDBServer).DeleteMetadataIndex(ctx, req.(*DeleteMetadataIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexKeysRequest)
+func _GeoDB_ListMetadataIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataIndexesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+		return srv.(GeoDBServer).ListMetadataIndexes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegexKeys",
+		FullMethod: "/api.GeoDB/ListMetadataIndexes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+		return srv.(GeoDBServer).ListMetadataIndexes(ctx, req.(*ListMetadataIndexesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixKeysRequest)
+func _GeoDB_CreateGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGeofenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
+		return srv.(GeoDBServer).CreateGeofence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefixKeys",
+		FullMethod: "/api.GeoDB/CreateGeofence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+		return srv.(GeoDBServer).CreateGeofence(ctx, req.(*CreateGeofenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _GeoDB_DeleteGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGeofenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Delete(ctx, in)
+		return srv.(GeoDBServer).DeleteGeofence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Delete",
+		FullMethod: "/api.GeoDB/DeleteGeofence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(GeoDBServer).DeleteGeofence(ctx, req.(*DeleteGeofenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GeoDB_ListGeofences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGeofencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
-}
-
-type GeoDB_StreamServer interface {
-	Send(*StreamResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *geoDBStreamServer) Send(m *StreamResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRegexRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GeoDBServer).ListGeofences(ctx, in)
 	}
-	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
-}
-
-type GeoDB_StreamRegexServer interface {
-	Send(*StreamRegexResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamRegexServer struct {
-	grpc.ServerStream
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ListGeofences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ListGeofences(ctx, req.(*ListGeofencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GeoDB_GetGeofenceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeofenceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetGeofenceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetGeofenceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetGeofenceEvents(ctx, req.(*GetGeofenceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamPrefixRequest)
+func _GeoDB_StreamGeofence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGeofenceRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
+	return srv.(GeoDBServer).StreamGeofence(m, &geoDBStreamGeofenceServer{stream})
 }
 
-type GeoDB_StreamPrefixServer interface {
-	Send(*StreamPrefixResponse) error
+type GeoDB_StreamGeofenceServer interface {
+	Send(*StreamGeofenceResponse) error
 	grpc.ServerStream
 }
 
-type geoDBStreamPrefixServer struct {
+type geoDBStreamGeofenceServer struct {
 	grpc.ServerStream
 }
 
-func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
+func (x *geoDBStreamGeofenceServer) Send(m *StreamGeofenceResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanBoundRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GeoDBServer).ScanBound(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanBound",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegexBoundRequest)
+func _GeoDB_GetTrajectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrajectoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+		return srv.(GeoDBServer).GetTrajectory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanRegexBound",
+		FullMethod: "/api.GeoDB/GetTrajectory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+		return srv.(GeoDBServer).GetTrajectory(ctx, req.(*GetTrajectoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanPrefixBoundRequest)
+func _GeoDB_GetPointAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointAtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+		return srv.(GeoDBServer).GetPointAt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanPrefixBound",
+		FullMethod: "/api.GeoDB/GetPointAt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+		return srv.(GeoDBServer).GetPointAt(ctx, req.(*GetPointAtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2734,6 +8259,27 @@ func _GeoDB_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GeoDB_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).Backup(m, &geoDBBackupServer{stream})
+}
+
+type GeoDB_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type geoDBBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _GeoDB_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.GeoDB",
 	HandlerType: (*GeoDBServer)(nil),
@@ -2746,6 +8292,10 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Set",
 			Handler:    _GeoDB_Set_Handler,
 		},
+		{
+			MethodName: "SetBatch",
+			Handler:    _GeoDB_SetBatch_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _GeoDB_Get_Handler,
@@ -2786,12 +8336,92 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScanPrefixBound",
 			Handler:    _GeoDB_ScanPrefixBound_Handler,
 		},
+		{
+			MethodName: "ScanPolygon",
+			Handler:    _GeoDB_ScanPolygon_Handler,
+		},
+		{
+			MethodName: "Nearby",
+			Handler:    _GeoDB_Nearby_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _GeoDB_Count_Handler,
+		},
+		{
+			MethodName: "Heatmap",
+			Handler:    _GeoDB_Heatmap_Handler,
+		},
+		{
+			MethodName: "ExportGeoJSON",
+			Handler:    _GeoDB_ExportGeoJSON_Handler,
+		},
+		{
+			MethodName: "ImportGeoJSON",
+			Handler:    _GeoDB_ImportGeoJSON_Handler,
+		},
+		{
+			MethodName: "CreateMetadataIndex",
+			Handler:    _GeoDB_CreateMetadataIndex_Handler,
+		},
+		{
+			MethodName: "DeleteMetadataIndex",
+			Handler:    _GeoDB_DeleteMetadataIndex_Handler,
+		},
+		{
+			MethodName: "ListMetadataIndexes",
+			Handler:    _GeoDB_ListMetadataIndexes_Handler,
+		},
+		{
+			MethodName: "CreateGeofence",
+			Handler:    _GeoDB_CreateGeofence_Handler,
+		},
+		{
+			MethodName: "DeleteGeofence",
+			Handler:    _GeoDB_DeleteGeofence_Handler,
+		},
+		{
+			MethodName: "ListGeofences",
+			Handler:    _GeoDB_ListGeofences_Handler,
+		},
+		{
+			MethodName: "GetGeofenceEvents",
+			Handler:    _GeoDB_GetGeofenceEvents_Handler,
+		},
+		{
+			MethodName: "GetTrajectory",
+			Handler:    _GeoDB_GetTrajectory_Handler,
+		},
+		{
+			MethodName: "GetPointAt",
+			Handler:    _GeoDB_GetPointAt_Handler,
+		},
 		{
 			MethodName: "GetPoint",
 			Handler:    _GeoDB_GetPoint_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SetStream",
+			Handler:       _GeoDB_SetStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetStream",
+			Handler:       _GeoDB_GetStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRegexStream",
+			Handler:       _GeoDB_GetRegexStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPrefixStream",
+			Handler:       _GeoDB_GetPrefixStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Stream",
 			Handler:       _GeoDB_Stream_Handler,
@@ -2807,6 +8437,56 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GeoDB_StreamPrefix_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamBound",
+			Handler:       _GeoDB_StreamBound_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPolygon",
+			Handler:       _GeoDB_StreamPolygon_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanBoundStream",
+			Handler:       _GeoDB_ScanBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanRegexBoundStream",
+			Handler:       _GeoDB_ScanRegexBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPrefixBoundStream",
+			Handler:       _GeoDB_ScanPrefixBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPolygonStream",
+			Handler:       _GeoDB_ScanPolygonStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCSV",
+			Handler:       _GeoDB_ImportCSV_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportTrace",
+			Handler:       _GeoDB_ImportTrace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGeofence",
+			Handler:       _GeoDB_StreamGeofence_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _GeoDB_Backup_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
@@ -28,6 +28,39 @@ func (this *Bound) Validate() error {
 	}
 	return nil
 }
+func (this *Polygon) Validate() error {
+	if len(this.Points) < 3 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Points", fmt.Errorf(`value '%v' must contain at least 3 elements`, this.Points))
+	}
+	for _, item := range this.Points {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Points", err)
+			}
+		}
+	}
+	for _, item := range this.Holes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Holes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PolygonHole) Validate() error {
+	if len(this.Points) < 3 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Points", fmt.Errorf(`value '%v' must contain at least 3 elements`, this.Points))
+	}
+	for _, item := range this.Points {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Points", err)
+			}
+		}
+	}
+	return nil
+}
 
 var _regex_Object_Key = regexp.MustCompile(`^.{1,225}$`)
 
@@ -112,7 +145,154 @@ func (this *ObjectDetail) Validate() error {
 	}
 	return nil
 }
+func (this *ObjectEvent) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	if this.Previous != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Previous); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Previous", err)
+		}
+	}
+	return nil
+}
+
+var _regex_MetadataCondition_Field = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *MetadataCondition) Validate() error {
+	if !_regex_MetadataCondition_Field.MatchString(this.Field) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Field", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Field))
+	}
+	return nil
+}
+func (this *MetadataFilter) Validate() error {
+	for _, item := range this.Conditions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Conditions", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_Geofence_Name = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *Geofence) Validate() error {
+	if !_regex_Geofence_Name.MatchString(this.Name) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Name))
+	}
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *GeofenceEvent) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+
+var _regex_CreateMetadataIndexRequest_Field = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *CreateMetadataIndexRequest) Validate() error {
+	if !_regex_CreateMetadataIndexRequest_Field.MatchString(this.Field) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Field", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Field))
+	}
+	return nil
+}
+func (this *CreateMetadataIndexResponse) Validate() error {
+	return nil
+}
+func (this *DeleteMetadataIndexRequest) Validate() error {
+	return nil
+}
+func (this *DeleteMetadataIndexResponse) Validate() error {
+	return nil
+}
+func (this *ListMetadataIndexesRequest) Validate() error {
+	return nil
+}
+func (this *ListMetadataIndexesResponse) Validate() error {
+	return nil
+}
+func (this *CreateGeofenceRequest) Validate() error {
+	if nil == this.Geofence {
+		return github_com_mwitkow_go_proto_validators.FieldError("Geofence", fmt.Errorf("message must exist"))
+	}
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+func (this *CreateGeofenceResponse) Validate() error {
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+func (this *DeleteGeofenceRequest) Validate() error {
+	return nil
+}
+func (this *DeleteGeofenceResponse) Validate() error {
+	return nil
+}
+func (this *ListGeofencesRequest) Validate() error {
+	return nil
+}
+func (this *ListGeofencesResponse) Validate() error {
+	for _, item := range this.Geofences {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Geofences", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetGeofenceEventsRequest) Validate() error {
+	return nil
+}
+func (this *GetGeofenceEventsResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *StreamGeofenceRequest) Validate() error {
+	return nil
+}
+func (this *StreamGeofenceResponse) Validate() error {
+	if this.Event != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Event); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Event", err)
+		}
+	}
+	return nil
+}
 func (this *StreamRequest) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
 func (this *StreamResponse) Validate() error {
@@ -130,6 +310,7 @@ func (this *StreamRegexRequest) Validate() error {
 	if !_regex_StreamRegexRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
 func (this *StreamRegexResponse) Validate() error {
@@ -147,6 +328,7 @@ func (this *StreamPrefixRequest) Validate() error {
 	if !_regex_StreamPrefixRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
 func (this *StreamPrefixResponse) Validate() error {
@@ -157,6 +339,46 @@ func (this *StreamPrefixResponse) Validate() error {
 	}
 	return nil
 }
+func (this *StreamBoundRequest) Validate() error {
+	if nil == this.Bound {
+		return github_com_mwitkow_go_proto_validators.FieldError("Bound", fmt.Errorf("message must exist"))
+	}
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *StreamBoundResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *StreamPolygonRequest) Validate() error {
+	if nil == this.Polygon {
+		return github_com_mwitkow_go_proto_validators.FieldError("Polygon", fmt.Errorf("message must exist"))
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *StreamPolygonResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
 func (this *SetRequest) Validate() error {
 	if nil == this.Object {
 		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf("message must exist"))
@@ -176,7 +398,51 @@ func (this *SetResponse) Validate() error {
 	}
 	return nil
 }
+func (this *SetBatchRequest) Validate() error {
+	if len(this.Objects) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Objects", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Objects))
+	}
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetResult) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *SetBatchResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetStreamResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *GetKeysRequest) Validate() error {
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetKeysResponse) Validate() error {
@@ -189,6 +455,9 @@ func (this *GetPrefixKeysRequest) Validate() error {
 	if !_regex_GetPrefixKeysRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetPrefixKeysResponse) Validate() error {
@@ -201,12 +470,23 @@ func (this *GetRegexKeysRequest) Validate() error {
 	if !_regex_GetRegexKeysRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetRegexKeysResponse) Validate() error {
 	return nil
 }
 func (this *GetRequest) Validate() error {
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetResponse) Validate() error {
@@ -220,6 +500,14 @@ func (this *GetRegexRequest) Validate() error {
 	if !_regex_GetRegexRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetRegexResponse) Validate() error {
@@ -233,12 +521,44 @@ func (this *GetPrefixRequest) Validate() error {
 	if !_regex_GetPrefixRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *GetPrefixResponse) Validate() error {
 	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
+func (this *GetStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *GetRegexStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *GetPrefixStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
 func (this *DeleteRequest) Validate() error {
 	return nil
 }
@@ -251,6 +571,14 @@ func (this *ScanBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *ScanBoundResponse) Validate() error {
@@ -263,6 +591,14 @@ func (this *ScanPrefixBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *ScanPrefixBoundResponse) Validate() error {
@@ -275,12 +611,318 @@ func (this *ScanRegexBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
 	return nil
 }
 func (this *ScanRegexBoundResponse) Validate() error {
 	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
+func (this *ScanPolygonRequest) Validate() error {
+	if nil == this.Polygon {
+		return github_com_mwitkow_go_proto_validators.FieldError("Polygon", fmt.Errorf("message must exist"))
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	return nil
+}
+func (this *ScanPolygonResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanBoundStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *ScanRegexBoundStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *ScanPrefixBoundStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *ScanPolygonStreamResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *NearbyRequest) Validate() error {
+	if nil == this.Point {
+		return github_com_mwitkow_go_proto_validators.FieldError("Point", fmt.Errorf("message must exist"))
+	}
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	if !(this.K > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("K", fmt.Errorf(`value '%v' must be greater than '0'`, this.K))
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	return nil
+}
+func (this *NearbyObject) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *NearbyResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CountRequest) Validate() error {
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	return nil
+}
+func (this *Aggregate) Validate() error {
+	return nil
+}
+func (this *CountResponse) Validate() error {
+	if this.Total != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Total); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Total", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *HeatmapRequest) Validate() error {
+	if nil == this.Bound {
+		return github_com_mwitkow_go_proto_validators.FieldError("Bound", fmt.Errorf("message must exist"))
+	}
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if !(this.Precision > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Precision", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Precision))
+	}
+	if !(this.Precision < 13) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Precision", fmt.Errorf(`value '%v' must be less than '13'`, this.Precision))
+	}
+	if !(this.Zoom > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Zoom", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Zoom))
+	}
+	if !(this.Zoom < 32) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Zoom", fmt.Errorf(`value '%v' must be less than '32'`, this.Zoom))
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	return nil
+}
+func (this *HeatmapCell) Validate() error {
+	if this.Centroid != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Centroid); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Centroid", err)
+		}
+	}
+	return nil
+}
+func (this *HeatmapResponse) Validate() error {
+	for _, item := range this.Cells {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Cells", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ExportGeoJSONRequest) Validate() error {
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	return nil
+}
+func (this *ExportGeoJSONResponse) Validate() error {
+	return nil
+}
+func (this *ImportGeoJSONRequest) Validate() error {
+	if this.FeatureCollection == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("FeatureCollection", fmt.Errorf(`value '%v' must not be an empty string`, this.FeatureCollection))
+	}
+	if !(this.DefaultRadius > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DefaultRadius", fmt.Errorf(`value '%v' must be greater than '-1'`, this.DefaultRadius))
+	}
+	return nil
+}
+func (this *ImportGeoJSONResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ImportCSVRequest) Validate() error {
+	if !(this.DefaultRadius > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DefaultRadius", fmt.Errorf(`value '%v' must be greater than '-1'`, this.DefaultRadius))
+	}
+	return nil
+}
+func (this *CSVRowError) Validate() error {
+	return nil
+}
+func (this *ImportCSVResponse) Validate() error {
+	for _, item := range this.Errors {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Errors", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_ImportTraceRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *ImportTraceRequest) Validate() error {
+	if !_regex_ImportTraceRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if this.Trace == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Trace", fmt.Errorf(`value '%v' must not be an empty string`, this.Trace))
+	}
+	if !(this.Radius > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Radius", fmt.Errorf(`value '%v' must be greater than '0'`, this.Radius))
+	}
+	if this.Tracking != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tracking); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tracking", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !(this.Speed >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Speed", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Speed))
+	}
+	return nil
+}
+func (this *ImportTraceResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetTrajectoryRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *GetTrajectoryRequest) Validate() error {
+	if !_regex_GetTrajectoryRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	return nil
+}
+func (this *GetTrajectoryResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetPointAtRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *GetPointAtRequest) Validate() error {
+	if !_regex_GetPointAtRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if !(this.TimestampUnix > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimestampUnix", fmt.Errorf(`value '%v' must be greater than '0'`, this.TimestampUnix))
+	}
+	return nil
+}
+func (this *GetPointAtResponse) Validate() error {
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	return nil
+}
 func (this *GetPointRequest) Validate() error {
 	return nil
 }
@@ -292,6 +934,12 @@ func (this *GetPointResponse) Validate() error {
 	}
 	return nil
 }
+func (this *BackupRequest) Validate() error {
+	return nil
+}
+func (this *BackupResponse) Validate() error {
+	return nil
+}
 func (this *PingRequest) Validate() error {
 	return nil
 }
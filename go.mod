@@ -16,7 +16,7 @@ require (
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/mwitkow/go-proto-validators v0.3.0
 	github.com/paulmach/go.geo v0.0.0-20180829195134-22b514266d33
-	github.com/paulmach/go.geojson v1.4.0 // indirect
+	github.com/paulmach/go.geojson v1.4.0
 	github.com/piotrkowalczuk/promgrpc/v3 v3.2.4
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/prometheus/client_golang v1.5.1
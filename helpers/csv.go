@@ -0,0 +1,125 @@
+package helpers
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"strconv"
+	"strings"
+)
+
+// default csv column names
+const (
+	KeyColumn     = "id"
+	LatColumn     = "lat"
+	LonColumn     = "lon"
+	RadiusColumn  = "radius"
+	ExpiresColumn = "expires_unix"
+)
+
+// CSVMapping configures which csv columns are converted to which object fields. Empty column names use the defaults.
+type CSVMapping struct {
+	KeyColumn     string
+	LatColumn     string
+	LonColumn     string
+	RadiusColumn  string
+	ExpiresColumn string
+	DefaultRadius int64 //the radius of rows without a radius column or value
+}
+
+// CSVColumns converts csv records to objects
+type CSVColumns struct {
+	header                         []string
+	key, lat, lon, radius, expires int
+	defaultRadius                  int64
+}
+
+// NewCSVColumns finds the mapped columns in the csv header. The key, lat & lon columns are required- the radius & expires columns are optional.
+// Every other column is added to the object metadata.
+func NewCSVColumns(header []string, mapping CSVMapping) (*CSVColumns, error) {
+	column := func(name, fallback string, required bool) (int, error) {
+		if name == "" {
+			name = fallback
+		}
+		for i, h := range header {
+			if strings.TrimSpace(h) == name {
+				return i, nil
+			}
+		}
+		if required {
+			return -1, fmt.Errorf("csv header is missing the %s column", name)
+		}
+		return -1, nil
+	}
+	c := &CSVColumns{
+		header:        header,
+		defaultRadius: mapping.DefaultRadius,
+	}
+	var err error
+	if c.key, err = column(mapping.KeyColumn, KeyColumn, true); err != nil {
+		return nil, err
+	}
+	if c.lat, err = column(mapping.LatColumn, LatColumn, true); err != nil {
+		return nil, err
+	}
+	if c.lon, err = column(mapping.LonColumn, LonColumn, true); err != nil {
+		return nil, err
+	}
+	if c.radius, err = column(mapping.RadiusColumn, RadiusColumn, false); err != nil {
+		return nil, err
+	}
+	if c.expires, err = column(mapping.ExpiresColumn, ExpiresColumn, false); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Key returns the key of the record(empty if the record has no key column)
+func (c *CSVColumns) Key(record []string) string {
+	if c.key >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[c.key])
+}
+
+// Object converts the csv record to an object. Empty values of columns that aren't mapped to object fields are left out of the metadata.
+func (c *CSVColumns) Object(record []string) (*api.Object, error) {
+	if len(record) != len(c.header) {
+		return nil, fmt.Errorf("expected %v columns, got: %v", len(c.header), len(record))
+	}
+	obj := &api.Object{
+		Key:      c.Key(record),
+		Point:    &api.Point{},
+		Radius:   c.defaultRadius,
+		Metadata: map[string]string{},
+	}
+	var err error
+	if obj.Point.Lat, err = strconv.ParseFloat(strings.TrimSpace(record[c.lat]), 64); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", c.header[c.lat], err.Error())
+	}
+	if obj.Point.Lon, err = strconv.ParseFloat(strings.TrimSpace(record[c.lon]), 64); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", c.header[c.lon], err.Error())
+	}
+	for _, field := range []struct {
+		column int
+		value  *int64
+	}{
+		{c.radius, &obj.Radius},
+		{c.expires, &obj.ExpiresUnix},
+	} {
+		if field.column < 0 || strings.TrimSpace(record[field.column]) == "" {
+			continue
+		}
+		val, err := strconv.ParseFloat(strings.TrimSpace(record[field.column]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", c.header[field.column], err.Error())
+		}
+		*field.value = int64(val)
+	}
+	for i, val := range record {
+		if i == c.key || i == c.lat || i == c.lon || i == c.radius || i == c.expires || val == "" {
+			continue
+		}
+		obj.Metadata[strings.TrimSpace(c.header[i])] = val
+	}
+	return obj, nil
+}
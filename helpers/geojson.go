@@ -0,0 +1,160 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geojson "github.com/paulmach/go.geojson"
+	"math"
+	"strconv"
+)
+
+// feature properties written by ObjectFeature
+const (
+	RadiusProperty        = "radius"
+	MetadataProperty      = "metadata"
+	ExpiresProperty       = "expires_unix"
+	UpdatedProperty       = "updated_unix"
+	TrackerEventsProperty = "tracker_events"
+)
+
+// FeatureMapping configures which GeoJSON feature properties are converted to which object fields
+type FeatureMapping struct {
+	KeyProperty        string   //the property that holds the object key. the feature's id is used if empty
+	RadiusProperty     string   //the property that holds the object radius. defaults to RadiusProperty
+	DefaultRadius      int64    //the radius of features without a radius property
+	MetadataProperties []string //the properties that are added to the object metadata. all other properties are added if empty
+}
+
+// ObjectFeature converts the object to a GeoJSON point feature with the object key as its id & its radius, metadata, updated_unix & expires_unix as properties.
+// If trackerEvents is true, the objects tracker events are added as the tracker_events property.
+func ObjectFeature(detail *api.ObjectDetail, trackerEvents bool) (*geojson.Feature, error) {
+	obj := detail.Object
+	feature := geojson.NewPointFeature([]float64{obj.Point.Lon, obj.Point.Lat})
+	feature.ID = obj.Key
+	feature.Properties[RadiusProperty] = obj.Radius
+	feature.Properties[UpdatedProperty] = obj.UpdatedUnix
+	if obj.ExpiresUnix > 0 {
+		feature.Properties[ExpiresProperty] = obj.ExpiresUnix
+	}
+	if len(obj.Metadata) > 0 {
+		feature.Properties[MetadataProperty] = obj.Metadata
+	}
+	if trackerEvents && len(detail.TrackerEvents) > 0 {
+		var events []json.RawMessage
+		for _, event := range detail.TrackerEvents {
+			str, err := jpb.MarshalToString(event)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, json.RawMessage(str))
+		}
+		feature.Properties[TrackerEventsProperty] = events
+	}
+	return feature, nil
+}
+
+// FeatureObject converts a GeoJSON point feature to an object using the mapping. Properties written by ObjectFeature are converted back to the same object fields.
+func FeatureObject(feature *geojson.Feature, mapping FeatureMapping) (*api.Object, error) {
+	if feature.Geometry == nil || !feature.Geometry.IsPoint() || len(feature.Geometry.Point) < 2 {
+		return nil, errors.New("feature geometry must be a point")
+	}
+	key, err := featureKey(feature, mapping.KeyProperty)
+	if err != nil {
+		return nil, err
+	}
+	radiusProperty := mapping.RadiusProperty
+	if radiusProperty == "" {
+		radiusProperty = RadiusProperty
+	}
+	obj := &api.Object{
+		Key: key,
+		Point: &api.Point{
+			Lat: feature.Geometry.Point[1],
+			Lon: feature.Geometry.Point[0],
+		},
+		Radius:   mapping.DefaultRadius,
+		Metadata: map[string]string{},
+	}
+	for _, field := range []struct {
+		property string
+		value    *int64
+	}{
+		{radiusProperty, &obj.Radius},
+		{ExpiresProperty, &obj.ExpiresUnix},
+		{UpdatedProperty, &obj.UpdatedUnix},
+	} {
+		if val, ok := feature.Properties[field.property]; ok && val != nil {
+			num, ok := val.(float64)
+			if !ok {
+				return nil, fmt.Errorf("property %s must be a number", field.property)
+			}
+			*field.value = int64(math.Round(num))
+		}
+	}
+	include := func(property string) bool {
+		if len(mapping.MetadataProperties) == 0 {
+			return true
+		}
+		for _, p := range mapping.MetadataProperties {
+			if p == property {
+				return true
+			}
+		}
+		return false
+	}
+	for property, val := range feature.Properties {
+		switch property {
+		case mapping.KeyProperty, radiusProperty, ExpiresProperty, UpdatedProperty, TrackerEventsProperty:
+			continue
+		case MetadataProperty:
+			if nested, ok := val.(map[string]interface{}); ok {
+				for k, v := range nested {
+					if include(k) && v != nil {
+						obj.Metadata[k] = propertyString(v)
+					}
+				}
+				continue
+			}
+		}
+		if include(property) && val != nil {
+			obj.Metadata[property] = propertyString(val)
+		}
+	}
+	return obj, nil
+}
+
+// featureKey returns the value of the key property- or the feature's id if the key property is empty
+func featureKey(feature *geojson.Feature, keyProperty string) (string, error) {
+	val := feature.ID
+	if keyProperty != "" {
+		val = feature.Properties[keyProperty]
+	}
+	if val == nil {
+		if keyProperty != "" {
+			return "", fmt.Errorf("feature is missing the key property %s", keyProperty)
+		}
+		return "", errors.New("feature is missing an id")
+	}
+	key := propertyString(val)
+	if key == "" {
+		return "", errors.New("feature key must not be empty")
+	}
+	return key, nil
+}
+
+// propertyString converts a json decoded property value to a string
+func propertyString(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		bits, _ := json.Marshal(v)
+		return string(bits)
+	}
+}
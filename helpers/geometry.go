@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	"math"
+)
+
+// ValidatePolygon returns an error if the polygon crosses the antimeridian. Polygons are planar in lat/lon- an edge between longitudes more than 180 degrees
+// apart would be read as spanning the rest of the globe, so such polygons are rejected rather than guessing which way around the globe they go.
+// An area that crosses the antimeridian has to be split into a polygon on either side of it.
+func ValidatePolygon(polygon *api.Polygon) error {
+	if polygon == nil {
+		return nil
+	}
+	rings := [][]*api.Point{polygon.Points}
+	for _, hole := range polygon.Holes {
+		rings = append(rings, hole.Points)
+	}
+	for _, ring := range rings {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			if math.Abs(ring[i].Lon-ring[j].Lon) > 180 {
+				return fmt.Errorf("polygons that cross the antimeridian are not supported- the edge from lon %v to lon %v spans more than 180 degrees", ring[j].Lon, ring[i].Lon)
+			}
+		}
+	}
+	return nil
+}
+
+// PolygonContains returns true if the point is inside the polygons outer ring and outside of all of its holes(see ValidatePolygon)
+func PolygonContains(polygon *api.Polygon, point *api.Point) bool {
+	if !ringContains(polygon.Points, point) {
+		return false
+	}
+	for _, hole := range polygon.Holes {
+		if ringContains(hole.Points, point) {
+			return false
+		}
+	}
+	return true
+}
+
+// PolygonBound returns the bounding box of the polygons outer ring
+func PolygonBound(polygon *api.Polygon) *geo.Bound {
+	bound := geo.NewBound(polygon.Points[0].Lon, polygon.Points[0].Lon, polygon.Points[0].Lat, polygon.Points[0].Lat)
+	for _, p := range polygon.Points[1:] {
+		bound.Extend(geo.NewPointFromLatLng(p.Lat, p.Lon))
+	}
+	return bound
+}
+
+// ringContains uses the ray casting algorithm to determine whether the point is inside the ring
+func ringContains(ring []*api.Point, point *api.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > point.Lat) != (b.Lat > point.Lat) &&
+			point.Lon < (b.Lon-a.Lon)*(point.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// InterpolatePoint returns the point at ratio(0-1) of the way from a to b. The longitude is interpolated the short way around the globe, so that a path
+// across the antimeridian doesn't cross every other meridian instead.
+func InterpolatePoint(a, b *api.Point, ratio float64) *api.Point {
+	return &api.Point{
+		Lat: a.Lat + (b.Lat-a.Lat)*ratio,
+		Lon: normalizeLon(a.Lon + lonDelta(a.Lon, b.Lon)*ratio),
+	}
+}
+
+// lonDelta returns the difference of the longitudes from a to b the short way around the globe(within -180 & 180)
+func lonDelta(a, b float64) float64 {
+	delta := b - a
+	if delta > 180 {
+		delta -= 360
+	} else if delta < -180 {
+		delta += 360
+	}
+	return delta
+}
+
+// normalizeLon wraps the longitude into -180 to 180
+func normalizeLon(lon float64) float64 {
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	return lon
+}
+
+// BoundContains returns true if the objects point is within the bounds radius. If the bounds mode is CirclesIntersect, the objects radius is taken into account.
+func BoundContains(bound *api.Bound, obj *api.Object) bool {
+	dist := geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon).GeoDistanceFrom(geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon), true)
+	if bound.Mode == api.BoundMode_CirclesIntersect {
+		return dist <= bound.Radius+float64(obj.Radius)
+	}
+	return dist <= bound.Radius
+}
+
+// GeofenceContains returns true if the object is inside the geofences bound or polygon
+func GeofenceContains(fence *api.Geofence, obj *api.Object) bool {
+	if fence.Bound != nil {
+		return BoundContains(fence.Bound, obj)
+	}
+	if fence.Polygon != nil {
+		return PolygonContains(fence.Polygon, obj.Point)
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"strconv"
+)
+
+// ValidateMetadataFilter returns an error if a condition is missing its values or compares a number with a value that isn't a number
+func ValidateMetadataFilter(filter *api.MetadataFilter) error {
+	for _, c := range filter.GetConditions() {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		switch c.Op {
+		case api.MetadataOperator_Exists:
+		case api.MetadataOperator_Equal, api.MetadataOperator_In:
+			if len(c.Values) == 0 {
+				return fmt.Errorf("%s condition on %s requires a value", c.Op, c.Field)
+			}
+		default:
+			if len(c.Values) == 0 {
+				return fmt.Errorf("%s condition on %s requires a value", c.Op, c.Field)
+			}
+			if _, err := strconv.ParseFloat(c.Values[0], 64); err != nil {
+				return fmt.Errorf("%s condition on %s requires a number: %s", c.Op, c.Field, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// MetadataMatches returns true if the metadata satisfies every condition of the filter. A nil filter matches all metadata.
+// Numeric comparisons don't match values that aren't numbers.
+func MetadataMatches(filter *api.MetadataFilter, metadata map[string]string) bool {
+	for _, c := range filter.GetConditions() {
+		val, ok := metadata[c.Field]
+		if !ok {
+			return false
+		}
+		switch c.Op {
+		case api.MetadataOperator_Exists:
+		case api.MetadataOperator_Equal:
+			if len(c.Values) == 0 || val != c.Values[0] {
+				return false
+			}
+		case api.MetadataOperator_In:
+			found := false
+			for _, v := range c.Values {
+				if v == val {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		default:
+			if len(c.Values) == 0 {
+				return false
+			}
+			num, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return false
+			}
+			target, err := strconv.ParseFloat(c.Values[0], 64)
+			if err != nil {
+				return false
+			}
+			switch c.Op {
+			case api.MetadataOperator_GreaterThan:
+				ok = num > target
+			case api.MetadataOperator_GreaterThanOrEqual:
+				ok = num >= target
+			case api.MetadataOperator_LessThan:
+				ok = num < target
+			case api.MetadataOperator_LessThanOrEqual:
+				ok = num <= target
+			}
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/golang/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"math"
+	"sort"
+)
+
+const (
+	// TileExtent is the number of pixels along each side of a vector tile
+	TileExtent = 4096
+	// tileExtentBits is log2(TileExtent)
+	tileExtentBits = 12
+	// MaxTileZoom is the highest supported map tile zoom level
+	MaxTileZoom = 24
+	// maxMercatorLat is the highest latitude that can be projected onto a web mercator map tile
+	maxMercatorLat = 85.05112878
+	// boundSamples is the number of points sampled along each edge of a tile to find the point furthest from its center
+	boundSamples = 8
+)
+
+// TileFeature is a point feature of a vector tile layer
+type TileFeature struct {
+	X, Y       int64 //the pixel coordinates of the point within the tile extent
+	Properties map[string]string
+}
+
+// TileBound returns a circle around the center of the map tile that contains the entire tile
+func TileBound(z, x, y uint64) *api.Bound {
+	tile := geo.NewBoundFromMapTile(x, y, z)
+	center := tile.Center()
+	radius := 0.0
+	for i := 0; i <= boundSamples; i++ {
+		f := float64(i) / boundSamples
+		lat := tile.South() + f*(tile.North()-tile.South())
+		lon := tile.West() + f*(tile.East()-tile.West())
+		for _, p := range []*geo.Point{
+			geo.NewPointFromLatLng(lat, tile.West()),
+			geo.NewPointFromLatLng(lat, tile.East()),
+			geo.NewPointFromLatLng(tile.South(), lon),
+			geo.NewPointFromLatLng(tile.North(), lon),
+		} {
+			radius = math.Max(radius, center.GeoDistanceFrom(p, true))
+		}
+	}
+	return &api.Bound{
+		Center: &api.Point{
+			Lat: center.Lat(),
+			Lon: center.Lng(),
+		},
+		//pad the radius to make up for the distance between the samples
+		Radius: radius * 1.01,
+	}
+}
+
+// mercatorProject projects the point onto the web mercator map at the level(a zoom level- pixels are projected at the zoom level + tileExtentBits).
+// Latitudes beyond the limits of web mercator are clamped.
+func mercatorProject(point *api.Point, level uint64) (uint64, uint64) {
+	lat := math.Max(-maxMercatorLat, math.Min(maxMercatorLat, point.Lat))
+	return geo.ScalarMercator.Project(point.Lon, lat, level)
+}
+
+// TileIndex returns the x & y index of the map tile at the zoom level that contains the point
+func TileIndex(point *api.Point, z uint64) (x, y uint64) {
+	x, y = mercatorProject(point, z)
+	if max := uint64(1) << z; x >= max {
+		//the antimeridian(lon 180) projects onto the first tile past the edge of the map
+		x = max - 1
+	}
+	return x, y
+}
+
+// TilePixel returns the pixel coordinates of the point within the map tile. ok is false if the point is outside of the tile.
+func TilePixel(point *api.Point, z, x, y uint64) (px, py int64, ok bool) {
+	gx, gy := mercatorProject(point, z+tileExtentBits)
+	px, py = int64(gx)-int64(x<<tileExtentBits), int64(gy)-int64(y<<tileExtentBits)
+	return px, py, px >= 0 && px < TileExtent && py >= 0 && py < TileExtent
+}
+
+// EncodeVectorTile encodes the point features as a single layer mapbox vector tile(version 2.1)
+func EncodeVectorTile(layer string, features []TileFeature) []byte {
+	keys := map[string]uint64{}
+	values := map[string]uint64{}
+	var keyList, valueList []string
+	l := proto.NewBuffer(nil)
+	l.EncodeVarint(15<<3 | 0)
+	l.EncodeVarint(2)
+	l.EncodeVarint(1<<3 | 2)
+	l.EncodeStringBytes(layer)
+	for _, feature := range features {
+		tags := proto.NewBuffer(nil)
+		var names []string
+		for k := range feature.Properties {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			v := feature.Properties[k]
+			if _, ok := keys[k]; !ok {
+				keys[k] = uint64(len(keyList))
+				keyList = append(keyList, k)
+			}
+			if _, ok := values[v]; !ok {
+				values[v] = uint64(len(valueList))
+				valueList = append(valueList, v)
+			}
+			tags.EncodeVarint(keys[k])
+			tags.EncodeVarint(values[v])
+		}
+		geometry := proto.NewBuffer(nil)
+		//a single MoveTo command followed by the zigzag encoded coordinates
+		geometry.EncodeVarint(1&0x7 | 1<<3)
+		geometry.EncodeZigzag32(uint64(feature.X))
+		geometry.EncodeZigzag32(uint64(feature.Y))
+		f := proto.NewBuffer(nil)
+		f.EncodeVarint(2<<3 | 2)
+		f.EncodeRawBytes(tags.Bytes())
+		//geometry type POINT
+		f.EncodeVarint(3<<3 | 0)
+		f.EncodeVarint(1)
+		f.EncodeVarint(4<<3 | 2)
+		f.EncodeRawBytes(geometry.Bytes())
+		l.EncodeVarint(2<<3 | 2)
+		l.EncodeRawBytes(f.Bytes())
+	}
+	for _, k := range keyList {
+		l.EncodeVarint(3<<3 | 2)
+		l.EncodeStringBytes(k)
+	}
+	for _, v := range valueList {
+		value := proto.NewBuffer(nil)
+		value.EncodeVarint(1<<3 | 2)
+		value.EncodeStringBytes(v)
+		l.EncodeVarint(4<<3 | 2)
+		l.EncodeRawBytes(value.Bytes())
+	}
+	l.EncodeVarint(5<<3 | 0)
+	l.EncodeVarint(TileExtent)
+	tile := proto.NewBuffer(nil)
+	tile.EncodeVarint(3<<3 | 2)
+	tile.EncodeRawBytes(l.Bytes())
+	return tile.Bytes()
+}
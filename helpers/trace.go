@@ -0,0 +1,225 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TracePoint is a recorded location of a GPX track or KML placemark
+type TracePoint struct {
+	Point *api.Point
+	Time  time.Time //zero if the point was recorded without a timestamp
+}
+
+// ParseTrace returns the points of every track(GPX) or placemark(KML) in the document. If every point has a timestamp, the points are ordered by time-
+// otherwise they are returned in document order.
+func ParseTrace(format api.TraceFormat, data []byte) ([]TracePoint, error) {
+	var (
+		points []TracePoint
+		err    error
+	)
+	switch format {
+	case api.TraceFormat_GPX:
+		points, err = parseGPX(data)
+	case api.TraceFormat_KML:
+		points, err = parseKML(data)
+	default:
+		return nil, fmt.Errorf("unsupported trace format: %v", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return nil, errors.New("trace has no points")
+	}
+	for _, point := range points {
+		if point.Time.IsZero() {
+			return points, nil
+		}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	return points, nil
+}
+
+type gpxTrack struct {
+	Segments []struct {
+		Points []struct {
+			Lat  float64 `xml:"lat,attr"`
+			Lon  float64 `xml:"lon,attr"`
+			Time string  `xml:"time"`
+		} `xml:"trkpt"`
+	} `xml:"trkseg"`
+}
+
+func parseGPX(data []byte) ([]TracePoint, error) {
+	var points []TracePoint
+	if err := decodeElements(data, "trk", func(d *xml.Decoder, start xml.StartElement) error {
+		var track gpxTrack
+		if err := d.DecodeElement(&track, &start); err != nil {
+			return err
+		}
+		for _, segment := range track.Segments {
+			for _, pt := range segment.Points {
+				point := TracePoint{
+					Point: &api.Point{Lat: pt.Lat, Lon: pt.Lon},
+				}
+				if pt.Time != "" {
+					t, err := parseTraceTime(pt.Time)
+					if err != nil {
+						return err
+					}
+					point.Time = t
+				}
+				points = append(points, point)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode gpx: %s", err.Error())
+	}
+	return points, nil
+}
+
+type kmlGeometry struct {
+	Points          []string      `xml:"Point>coordinates"`
+	LineStrings     []string      `xml:"LineString>coordinates"`
+	Tracks          []kmlTrack    `xml:"Track"`
+	MultiTracks     []kmlGeometry `xml:"MultiTrack"`
+	MultiGeometries []kmlGeometry `xml:"MultiGeometry"`
+}
+
+// kmlTrack is a gx:Track- a time-ordered series of when & gx:coord elements
+type kmlTrack struct {
+	Whens  []string `xml:"when"`
+	Coords []string `xml:"coord"`
+}
+
+type kmlPlacemark struct {
+	When string `xml:"TimeStamp>when"`
+	kmlGeometry
+}
+
+func parseKML(data []byte) ([]TracePoint, error) {
+	var points []TracePoint
+	if err := decodeElements(data, "Placemark", func(d *xml.Decoder, start xml.StartElement) error {
+		var placemark kmlPlacemark
+		if err := d.DecodeElement(&placemark, &start); err != nil {
+			return err
+		}
+		var when time.Time
+		if placemark.When != "" {
+			t, err := parseTraceTime(placemark.When)
+			if err != nil {
+				return err
+			}
+			when = t
+		}
+		pts, err := placemark.points(when)
+		if err != nil {
+			return err
+		}
+		points = append(points, pts...)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode kml: %s", err.Error())
+	}
+	return points, nil
+}
+
+// points returns the points of the geometry. Points & line strings have the placemarks timestamp(when)- track points have their own.
+func (g kmlGeometry) points(when time.Time) ([]TracePoint, error) {
+	var points []TracePoint
+	for _, coordinates := range append(g.Points, g.LineStrings...) {
+		for _, tuple := range strings.Fields(coordinates) {
+			point, err := parseCoordinates(strings.Split(tuple, ","))
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, TracePoint{Point: point, Time: when})
+		}
+	}
+	for _, track := range g.Tracks {
+		if len(track.Whens) > 0 && len(track.Whens) != len(track.Coords) {
+			return nil, fmt.Errorf("track has %v timestamps & %v coordinates", len(track.Whens), len(track.Coords))
+		}
+		for i, coord := range track.Coords {
+			point, err := parseCoordinates(strings.Fields(coord))
+			if err != nil {
+				return nil, err
+			}
+			tp := TracePoint{Point: point}
+			if len(track.Whens) > 0 {
+				if tp.Time, err = parseTraceTime(track.Whens[i]); err != nil {
+					return nil, err
+				}
+			}
+			points = append(points, tp)
+		}
+	}
+	for _, multi := range append(g.MultiTracks, g.MultiGeometries...) {
+		pts, err := multi.points(when)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pts...)
+	}
+	return points, nil
+}
+
+// parseCoordinates parses kml coordinates(lon, lat & an optional altitude)
+func parseCoordinates(values []string) (*api.Point, error) {
+	if len(values) < 2 {
+		return nil, fmt.Errorf("invalid coordinates: %s", strings.Join(values, ","))
+	}
+	lon, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude: %s", err.Error())
+	}
+	lat, err := strconv.ParseFloat(values[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude: %s", err.Error())
+	}
+	return &api.Point{Lat: lat, Lon: lon}, nil
+}
+
+// parseTraceTime parses an xml schema dateTime with or without a timezone(utc is assumed)
+func parseTraceTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %s: %s", value, err.Error())
+}
+
+// decodeElements calls fn for every element with the given local name in the xml document- regardless of how deeply it's nested
+func decodeElements(data []byte, name string, fn func(d *xml.Decoder, start xml.StartElement) error) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == name {
+			if err := fn(d, start); err != nil {
+				return err
+			}
+		}
+	}
+}
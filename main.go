@@ -1,19 +1,33 @@
 package main
 
 import (
+	"github.com/autom8ter/geodb/gateway"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/server"
 	"github.com/autom8ter/geodb/services"
 	log "github.com/sirupsen/logrus"
+	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		command, ok := commands[os.Args[1]]
+		if !ok {
+			log.Fatalf("unknown command: %s", os.Args[1])
+		}
+		if err := command(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
 	s, err := server.NewServer()
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 	s.Setup(func(server *server.Server) error {
-		api.RegisterGeoDBServer(s.GetGRPCServer(), services.NewGeoDB(s.GetDB(), s.GetStream(), s.GetGmaps()))
+		geoDB := services.NewGeoDB(s.GetDB(), s.GetStream(), s.GetGmaps())
+		api.RegisterGeoDBServer(s.GetGRPCServer(), geoDB)
+		gateway.Register(s.GetRouter(), geoDB)
 		return nil
 	})
 	s.Run()
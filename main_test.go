@@ -1,19 +1,47 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	geodb "github.com/autom8ter/geodb/db"
+	"github.com/autom8ter/geodb/gateway"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
 	"github.com/autom8ter/geodb/server"
 	"github.com/autom8ter/geodb/services"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+	geo "github.com/paulmach/go.geo"
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
 	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
 
 var (
 	geoDB      *services.GeoDB
+	hub        *stream.Hub
 	coorsField = &api.Point{
 		Lat: 39.756378173828125,
 		Lon: -104.99414825439453,
@@ -33,12 +61,24 @@ var (
 )
 
 func TestMain(t *testing.M) {
-	db, hub, gmaps, err := server.GetDeps()
+	// every run starts with a fresh data directory- deleting all objects keeps geofences, history & events around
+	dir, err := ioutil.TempDir("", "geodb-test")
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	config.Config.Set("GEODB_PATH", dir)
+	db, h, gmaps, err := server.GetDeps()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	hub = h
 	geoDB = services.NewGeoDB(db, hub, gmaps)
-	os.Exit(t.Run())
+	go hub.StartObjectStream(context.Background())
+	go hub.StartGeofenceStream(context.Background())
+	go geodb.WatchExpirations(context.Background(), db, hub, 100*time.Millisecond)
+	code := t.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
 }
 
 func TestPing(t *testing.T) {
@@ -226,7 +266,7 @@ func TestGetRegexKeys(t *testing.T) {
 }
 
 func TestScanBounds(t *testing.T) {
-	_, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+	resp, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
 		Bound: &api.Bound{
 			Center: coorsField,
 			Radius: 5000,
@@ -236,38 +276,2667 @@ func TestScanBounds(t *testing.T) {
 	if err != nil {
 		t.Fatal(err.Error())
 	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	resp, err = geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 5800,
+			Mode:   api.BoundMode_CirclesIntersect,
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 3 {
+		t.Fatal("expected 3 results")
+	}
 }
 
-func TestDelete(t *testing.T) {
-	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
-		Keys: []string{"testing_pepsi_center"},
+func TestScanPrefixBound(t *testing.T) {
+	resp, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+		Bound: &api.Bound{
+			Center: cherryCreekMall,
+			Radius: 10000,
+		},
+		Prefix: "malls_",
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	resp, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
-		Prefix: "testing_",
+	if len(resp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestScanRegexBound(t *testing.T) {
+	resp, err := geoDB.ScanRegexBound(context.Background(), &api.ScanRegexBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 10000,
+		},
+		Regex: "testing_*",
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	if len(resp.Keys) != 1 {
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+}
+
+func TestScanPolygon(t *testing.T) {
+	downtown := &api.Polygon{
+		Points: []*api.Point{
+			{Lat: 39.74, Lon: -105.02},
+			{Lat: 39.77, Lon: -105.02},
+			{Lat: 39.77, Lon: -104.98},
+			{Lat: 39.74, Lon: -104.98},
+		},
+	}
+	resp, err := geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+		Polygon: downtown,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	downtown.Holes = []*api.PolygonHole{
+		{
+			Points: []*api.Point{
+				{Lat: 39.745, Lon: -105.01},
+				{Lat: 39.752, Lon: -105.01},
+				{Lat: 39.752, Lon: -105.005},
+				{Lat: 39.745, Lon: -105.005},
+			},
+		},
+	}
+	resp, err = geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+		Polygon: downtown,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 {
 		t.Fatal("expected 1 results")
 	}
 }
 
-func TestDeleteAll(t *testing.T) {
-	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
-		Keys: []string{"*"},
+func TestPolygonAntimeridian(t *testing.T) {
+	// the polygon spans 20 degrees across the antimeridian, but its edges would be read as spanning the rest of the globe
+	polygon := &api.Polygon{
+		Points: []*api.Point{
+			{Lat: 0, Lon: 170},
+			{Lat: 10, Lon: 170},
+			{Lat: 10, Lon: -170},
+			{Lat: 0, Lon: -170},
+		},
+	}
+	_, err := geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+		Polygon: polygon,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a polygon across the antimeridian to be rejected, got: %v", err)
+	}
+	_, err = geoDB.CreateGeofence(context.Background(), &api.CreateGeofenceRequest{
+		Geofence: &api.Geofence{
+			Name:    "antimeridian",
+			Polygon: polygon,
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a geofence across the antimeridian to be rejected, got: %v", err)
+	}
+	// the same area is covered by a polygon on either side of the antimeridian
+	for _, half := range []*api.Polygon{
+		{Points: []*api.Point{{Lat: 0, Lon: 170}, {Lat: 10, Lon: 170}, {Lat: 10, Lon: 180}, {Lat: 0, Lon: 180}}},
+		{Points: []*api.Point{{Lat: 0, Lon: -180}, {Lat: 10, Lon: -180}, {Lat: 10, Lon: -170}, {Lat: 0, Lon: -170}}},
+	} {
+		if _, err := geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+			Polygon: half,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestNearby(t *testing.T) {
+	resp, err := geoDB.Nearby(context.Background(), &api.NearbyRequest{
+		Point: coorsField,
+		K:     2,
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	resp, err := geoDB.Get(context.Background(), &api.GetRequest{})
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	if resp.Objects[0].Object.Object.Key != "testing_coors" || resp.Objects[1].Object.Object.Key != "testing_pepsi_center" {
+		t.Fatal("expected results to be ordered by distance")
+	}
+	resp, err = geoDB.Nearby(context.Background(), &api.NearbyRequest{
+		Point:       coorsField,
+		K:           10,
+		MaxDistance: 1000,
+	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	if len(resp.Objects) != 0 {
-		t.Fatal("expected 0 results")
+	if len(resp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestGeofence(t *testing.T) {
+	_, err := geoDB.CreateGeofence(context.Background(), &api.CreateGeofenceRequest{
+		Geofence: &api.Geofence{
+			Name: "downtown",
+			Polygon: &api.Polygon{
+				Points: []*api.Point{
+					{Lat: 39.74, Lon: -105.02},
+					{Lat: 39.77, Lon: -105.02},
+					{Lat: 39.77, Lon: -104.98},
+					{Lat: 39.74, Lon: -104.98},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	fences, err := geoDB.ListGeofences(context.Background(), &api.ListGeofencesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(fences.Geofences) != 1 {
+		t.Fatal("expected 1 geofence")
+	}
+	for _, point := range []*api.Point{cherryCreekMall, coorsField, cherryCreekMall} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "geofence_car",
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.GetGeofenceEvents(context.Background(), &api.GetGeofenceEventsRequest{
+		Geofence: "downtown",
+		Key:      "geofence_car",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Events) != 2 {
+		t.Fatal("expected 2 events")
+	}
+	if resp.Events[0].Transition != api.Transition_Enter || resp.Events[1].Transition != api.Transition_Exit {
+		t.Fatal("expected an enter event followed by an exit event")
+	}
+	if _, err := geoDB.DeleteGeofence(context.Background(), &api.DeleteGeofenceRequest{
+		Names: []string{"downtown"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"geofence_car"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGeofenceMembership(t *testing.T) {
+	clientID := hub.AddGeofenceStreamClient("", &stream.GeofenceFilter{Names: []string{"membership"}})
+	events := hub.GetClientGeofenceStream(clientID)
+	defer hub.RemoveGeofenceStreamClient(clientID, events)
+	set := func(point *api.Point) {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "membership_car",
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	create := func(center *api.Point) {
+		if _, err := geoDB.CreateGeofence(context.Background(), &api.CreateGeofenceRequest{
+			Geofence: &api.Geofence{
+				Name: "membership",
+				Bound: &api.Bound{
+					Center: center,
+					Radius: 1000,
+				},
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	expectEvent := func(transition api.Transition) {
+		for {
+			select {
+			case event := <-events:
+				// other objects near the geofence enter & exit it as well
+				if event.Object.Key != "membership_car" {
+					continue
+				}
+				if event.Transition != transition {
+					t.Fatalf("expected an %s event, got: %s", transition, event.Transition)
+				}
+				return
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected an %s event", transition)
+			}
+		}
+	}
+	// the car is inside of the geofence before it is created
+	set(coorsField)
+	create(coorsField)
+	expectEvent(api.Transition_Enter)
+	set(cherryCreekMall)
+	expectEvent(api.Transition_Exit)
+	// replacing the geofence with one around the cars location enters it again
+	create(cherryCreekMall)
+	expectEvent(api.Transition_Enter)
+	resp, err := geoDB.GetGeofenceEvents(context.Background(), &api.GetGeofenceEventsRequest{
+		Geofence: "membership",
+		Key:      "membership_car",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Events) != 3 ||
+		resp.Events[0].Transition != api.Transition_Enter ||
+		resp.Events[1].Transition != api.Transition_Exit ||
+		resp.Events[2].Transition != api.Transition_Enter {
+		t.Fatalf("expected enter, exit & enter events, got: %v", resp.Events)
+	}
+	if _, err := geoDB.DeleteGeofence(context.Background(), &api.DeleteGeofenceRequest{
+		Names: []string{"membership"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"membership_car"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestTrackerTransition(t *testing.T) {
+	expected := []api.Transition{api.Transition_Outside, api.Transition_Enter, api.Transition_Inside, api.Transition_Exit}
+	for i, point := range []*api.Point{pepsiCenter, coorsField, coorsField, pepsiCenter} {
+		resp, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "tracker_courier",
+				Point:  point,
+				Radius: 10,
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{
+						{
+							TargetObjectKey: "testing_coors",
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Object.TrackerEvents) != 1 {
+			t.Fatal("expected 1 tracker event")
+		}
+		if resp.Object.TrackerEvents[0].Transition != expected[i] {
+			t.Fatalf("expected %s transition, got: %s", expected[i], resp.Object.TrackerEvents[0].Transition)
+		}
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"tracker_courier"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestReciprocalTracker(t *testing.T) {
+	objects := []*api.Object{
+		{
+			Key:    "reciprocal_driver",
+			Point:  pepsiCenter,
+			Radius: 10,
+		},
+		{
+			Key:    "reciprocal_customer",
+			Point:  coorsField,
+			Radius: 10,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: "reciprocal_driver",
+					},
+				},
+			},
+		},
+		{
+			Key:    "reciprocal_driver",
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: obj,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"reciprocal_customer"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	events := resp.Objects["reciprocal_customer"].TrackerEvents
+	if len(events) != 1 || events[0].Transition != api.Transition_Enter {
+		t.Fatal("expected the customer's tracker event to be updated when the driver moved")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"reciprocal_driver"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"reciprocal_customer"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	events = resp.Objects["reciprocal_customer"].TrackerEvents
+	if len(events) != 1 || events[0].Transition != api.Transition_Exit || events[0].Inside {
+		t.Fatal("expected the customer's tracker event to become an exit event when the driver was deleted")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"reciprocal_customer"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestHistory(t *testing.T) {
+	for i, point := range []*api.Point{coorsField, pepsiCenter} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         "history_van",
+				Point:       point,
+				Radius:      10,
+				UpdatedUnix: int64(1000 * (i + 1)),
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	trajectory, err := geoDB.GetTrajectory(context.Background(), &api.GetTrajectoryRequest{
+		Key: "history_van",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(trajectory.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	resp, err := geoDB.GetPointAt(context.Background(), &api.GetPointAtRequest{
+		Key:           "history_van",
+		TimestampUnix: 1500,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !resp.Interpolated || math.Abs(resp.Point.Lat-(coorsField.Lat+pepsiCenter.Lat)/2) > 0.000001 {
+		t.Fatal("expected the point to be interpolated halfway between samples")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"history_van"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGateway(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	req := httptest.NewRequest(http.MethodPost, "/api/GetPrefix", strings.NewReader(`{"prefix": "testing_"}`))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got: %v %s", rec.Code, rec.Body.String())
+	}
+	var resp = &api.GetPrefixResponse{}
+	if err := jsonpb.Unmarshal(rec.Body, resp); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	req = httptest.NewRequest(http.MethodPost, "/api/Set", strings.NewReader(`{"object": {"key": "invalid"}}`))
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got: %v", rec.Code)
+	}
+}
+
+func TestSSE(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/sse/Stream?keys=sse_car")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatal("expected an event stream")
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// keep publishing until the event is received since the stream client is registered asynchronously
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(50 * time.Millisecond):
+				geoDB.Set(context.Background(), &api.SetRequest{
+					Object: &api.Object{
+						Key:    "sse_car",
+						Point:  coorsField,
+						Radius: 10,
+					},
+				})
+			}
+		}
+	}()
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.HasPrefix(line, "id: ") {
+		t.Fatalf("expected an event id: %s", line)
+	}
+	line, err = reader.ReadString('\n')
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.HasPrefix(line, "data: ") || !strings.Contains(line, "sse_car") {
+		t.Fatalf("unexpected event: %s", line)
+	}
+}
+
+func TestStreamBound(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	resp, err := http.Get(fmt.Sprintf("%s/sse/StreamBound?lat=%v&lon=%v&radius=1000", srv.URL, coorsField.Lat, coorsField.Lon))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(50 * time.Millisecond):
+				for _, obj := range []*api.Object{
+					{
+						Key:    "bound_far_car",
+						Point:  cherryCreekMall,
+						Radius: 10,
+					},
+					{
+						Key:    "bound_near_car",
+						Point:  coorsField,
+						Radius: 10,
+					},
+				} {
+					geoDB.Set(context.Background(), &api.SetRequest{
+						Object: obj,
+					})
+				}
+			}
+		}
+	}()
+	reader := bufio.NewReader(resp.Body)
+	for i := 0; i < 3; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		if !strings.Contains(line, "bound_near_car") {
+			t.Fatalf("expected only objects within the bound: %s", line)
+		}
+	}
+}
+
+func TestStreamBoundExit(t *testing.T) {
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{
+		Keys: []string{"bound_exit_car"},
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 1000,
+		},
+	})
+	objects := hub.GetClientObjectStream(clientID)
+	defer hub.RemoveObjectStreamClient(clientID, objects)
+	for _, point := range []*api.Point{coorsField, cherryCreekMall, saintJosephHospital} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "bound_exit_car",
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"bound_exit_car"}})
+	for _, expected := range []api.EventType{api.EventType_Set, api.EventType_Exit} {
+		select {
+		case event := <-objects:
+			if event.Type != expected {
+				t.Fatalf("expected a %s event, got: %s", expected, event.Type)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for a %s event", expected)
+		}
+	}
+	select {
+	case event := <-objects:
+		t.Fatalf("expected no events once the object is outside the bound, got: %s", event.Type)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+// slowPrefixStream is a StreamPrefix server that records the sequences it's sent. The first send blocks until release is closed.
+type slowPrefixStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	blocked   chan struct{}
+	release   chan struct{}
+	once      sync.Once
+	mu        sync.Mutex
+	sequences []uint64
+}
+
+func (s *slowPrefixStream) Send(m *api.StreamPrefixResponse) error {
+	s.once.Do(func() {
+		close(s.blocked)
+	})
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sequences = append(s.sequences, m.Sequence)
+	return nil
+}
+
+func (s *slowPrefixStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *slowPrefixStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowPrefixStream) received() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint64{}, s.sequences...)
+}
+
+func TestResumeStreamWritesDuringReplay(t *testing.T) {
+	set := func(count int) {
+		var objects []*api.Object
+		for i := 0; i < count; i++ {
+			objects = append(objects, &api.Object{
+				Key:    fmt.Sprintf("replay_car_%v", i),
+				Point:  coorsField,
+				Radius: 10,
+			})
+		}
+		resp, err := geoDB.SetBatch(context.Background(), &api.SetBatchRequest{Objects: objects})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		for _, result := range resp.Results {
+			if result.Error != "" {
+				t.Fatal(result.Error)
+			}
+		}
+	}
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{Prefix: "replay_car_"})
+	events := hub.GetClientObjectStream(clientID)
+	set(1)
+	var resume uint64
+	select {
+	case event := <-events:
+		resume = event.Sequence
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a Set event")
+	}
+	hub.RemoveObjectStreamClient(clientID, events)
+	// the replay blocks on this event until the stream is released
+	set(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	ss := &slowPrefixStream{ctx: ctx, blocked: make(chan struct{}), release: make(chan struct{})}
+	done := make(chan error, 1)
+	go func() {
+		done <- geoDB.StreamPrefix(&api.StreamPrefixRequest{
+			Prefix:         "replay_car_",
+			ResumeSequence: resume,
+		}, ss)
+	}()
+	select {
+	case <-ss.blocked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the replay to send an event")
+	}
+	// more events than a stream clients queue holds are written while the replay is blocked
+	const written = 1500
+	set(written)
+	close(ss.release)
+	set(1)
+	deadline := time.Now().Add(10 * time.Second)
+	for len(ss.received()) < written+2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err.Error())
+	}
+	sequences := ss.received()
+	if len(sequences) != written+2 {
+		t.Fatalf("expected %v events, got: %v", written+2, len(sequences))
+	}
+	for i := 1; i < len(sequences); i++ {
+		if sequences[i] <= sequences[i-1] {
+			t.Fatalf("expected every event to be sent once in sequence order, got %v after %v", sequences[i], sequences[i-1])
+		}
+	}
+	var keys []string
+	for i := 0; i < written; i++ {
+		keys = append(keys, fmt.Sprintf("replay_car_%v", i))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamBackpressure(t *testing.T) {
+	slow := hub.AddObjectStreamClient("", nil)
+	slowObjects := hub.GetClientObjectStream(slow)
+	defer hub.RemoveObjectStreamClient(slow, slowObjects)
+	fast := hub.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"backpressure_car"}})
+	objects := hub.GetClientObjectStream(fast)
+	defer hub.RemoveObjectStreamClient(fast, objects)
+	const updates = 50
+	go func() {
+		for i := 0; i < updates; i++ {
+			if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+				Object: &api.Object{
+					Key:    "backpressure_car",
+					Point:  coorsField,
+					Radius: int64(i + 1),
+				},
+			}); err != nil {
+				t.Error(err.Error())
+			}
+		}
+	}()
+	received := 0
+	for received < updates {
+		select {
+		case event, ok := <-objects:
+			if !ok {
+				t.Fatal("expected the stream to stay open")
+			}
+			if event.Object.Object.Key == "backpressure_car" {
+				received++
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("a slow client blocked the stream: received %v/%v updates", received, updates)
+		}
+	}
+	select {
+	case event := <-slowObjects:
+		if event == nil {
+			t.Fatal("expected the slow client to be buffered")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the slow client to receive its buffered updates")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"backpressure_car"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+// streamMetric returns the value of the stream metric with the policy label(or without labels if policy is empty)
+func streamMetric(t *testing.T, name, policy string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if policy != "" && (len(metric.GetLabel()) != 1 || metric.GetLabel()[0].GetValue() != policy) {
+				continue
+			}
+			if metric.GetGauge() != nil {
+				return metric.GetGauge().GetValue()
+			}
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+// testOverflowPolicy publishes an event the client never reads followed by the events of keys to a client with a queue of 2 messages & expects
+// drops events to be dropped. It returns the keys(with the radius of the event) that the client receives after the event it never read.
+func testOverflowPolicy(t *testing.T, policy stream.OverflowPolicy, drops int, keys ...string) []string {
+	h := stream.NewHub(2, policy)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go h.StartObjectStream(ctx)
+	client := h.AddObjectStreamClient("", &stream.ObjectFilter{Prefix: "policy_"})
+	objects := h.GetClientObjectStream(client)
+	defer h.RemoveObjectStreamClient(client, objects)
+	barrier := h.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"barrier"}})
+	barrierObjects := h.GetClientObjectStream(barrier)
+	defer h.RemoveObjectStreamClient(barrier, barrierObjects)
+	publish := func(key string, radius int) {
+		h.PublishObjectEvent(&api.ObjectEvent{
+			Type: api.EventType_Set,
+			Object: &api.ObjectDetail{
+				Object: &api.Object{Key: key, Point: coorsField, Radius: int64(radius)},
+			},
+		})
+	}
+	// wait returns once every event published before has been pushed to the clients queues
+	wait := func() {
+		publish("barrier", 0)
+		select {
+		case <-barrierObjects:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the barrier event")
+		}
+		// the hub pushes an event to every client before it releases its lock
+		h.GetClientObjectStream(client)
+	}
+	depth := streamMetric(t, "stream_queue_depth", policy.String())
+	dropped := streamMetric(t, "stream_dropped_total", policy.String())
+	disconnected := streamMetric(t, "stream_disconnected_total", "")
+	// the client takes the first event off of its queue & blocks until it is read
+	publish("policy_blocked", 0)
+	wait()
+	deadline := time.Now().Add(5 * time.Second)
+	for streamMetric(t, "stream_queue_depth", policy.String()) != depth && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i, key := range keys {
+		publish(key, i+1)
+	}
+	wait()
+	queued := streamMetric(t, "stream_queue_depth", policy.String()) - depth
+	if policy == stream.Disconnect {
+		if queued != 0 {
+			t.Fatalf("expected the queue of a disconnected client to be released, got: %v", queued)
+		}
+		if got := streamMetric(t, "stream_disconnected_total", "") - disconnected; got != 1 {
+			t.Fatalf("expected 1 disconnected client, got: %v", got)
+		}
+	} else {
+		if queued != 2 {
+			t.Fatalf("expected a queue depth of 2, got: %v", queued)
+		}
+		if got := streamMetric(t, "stream_dropped_total", policy.String()) - dropped; got != float64(drops) {
+			t.Fatalf("expected %v dropped events, got: %v", drops, got)
+		}
+	}
+	var received []string
+	for {
+		select {
+		case event, ok := <-objects:
+			if !ok {
+				return received
+			}
+			if event.Object.Object.Key == "policy_blocked" {
+				continue
+			}
+			received = append(received, fmt.Sprintf("%s:%v", event.Object.Object.Key, event.Object.Object.Radius))
+			if len(received) == 2 {
+				if got := streamMetric(t, "stream_queue_depth", policy.String()); got != depth {
+					t.Fatalf("expected the queue to be empty, got a depth of: %v", got-depth)
+				}
+				return received
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected the queued events, got: %v", received)
+		}
+	}
+}
+
+func TestStreamDropNewest(t *testing.T) {
+	received := testOverflowPolicy(t, stream.DropNewest, 2, "policy_a", "policy_b", "policy_c", "policy_d")
+	if strings.Join(received, ",") != "policy_a:1,policy_b:2" {
+		t.Fatalf("expected the newest events to be dropped, got: %v", received)
+	}
+}
+
+func TestStreamDropOldest(t *testing.T) {
+	received := testOverflowPolicy(t, stream.DropOldest, 2, "policy_a", "policy_b", "policy_c", "policy_d")
+	if strings.Join(received, ",") != "policy_c:3,policy_d:4" {
+		t.Fatalf("expected the oldest events to be dropped, got: %v", received)
+	}
+}
+
+func TestStreamConflate(t *testing.T) {
+	// the second a & b replace the queued ones, c drops the oldest queued event(a)
+	received := testOverflowPolicy(t, stream.Conflate, 1, "policy_a", "policy_b", "policy_a", "policy_b", "policy_c")
+	if strings.Join(received, ",") != "policy_b:4,policy_c:5" {
+		t.Fatalf("expected the queued events to be conflated, got: %v", received)
+	}
+}
+
+func TestStreamDisconnect(t *testing.T) {
+	received := testOverflowPolicy(t, stream.Disconnect, 0, "policy_a", "policy_b", "policy_c")
+	if len(received) != 0 {
+		t.Fatalf("expected the slow client to be disconnected, got: %v", received)
+	}
+}
+
+func TestStreamReconnect(t *testing.T) {
+	h := stream.NewHub(10, stream.DropOldest)
+	h.AddObjectStreamClient("dash", nil)
+	previous := h.GetClientObjectStream("dash")
+	h.AddObjectStreamClient("dash", nil)
+	current := h.GetClientObjectStream("dash")
+	select {
+	case _, ok := <-previous:
+		if ok {
+			t.Fatal("expected the previous stream to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the previous stream to be closed when the client reconnects")
+	}
+	// the handler of the previous stream removes its client once it returns
+	h.RemoveObjectStreamClient("dash", previous)
+	if h.GetClientObjectStream("dash") != current {
+		t.Fatal("expected the reconnected client to be kept")
+	}
+	select {
+	case _, ok := <-current:
+		t.Fatalf("expected the reconnected stream to stay open, got: %v", ok)
+	case <-time.After(100 * time.Millisecond):
+	}
+	h.RemoveObjectStreamClient("dash", current)
+	if h.GetClientObjectStream("dash") != nil {
+		t.Fatal("expected the client to be removed")
+	}
+	h.AddGeofenceStreamClient("dash", nil)
+	previousFences := h.GetClientGeofenceStream("dash")
+	h.AddGeofenceStreamClient("dash", nil)
+	currentFences := h.GetClientGeofenceStream("dash")
+	h.RemoveGeofenceStreamClient("dash", previousFences)
+	if h.GetClientGeofenceStream("dash") != currentFences {
+		t.Fatal("expected the reconnected geofence client to be kept")
+	}
+	h.RemoveGeofenceStreamClient("dash", currentFences)
+}
+
+func TestStreamEvents(t *testing.T) {
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"event_car"}})
+	events := hub.GetClientObjectStream(clientID)
+	defer hub.RemoveObjectStreamClient(clientID, events)
+	expectEvent := func(eventType api.EventType) {
+		select {
+		case event := <-events:
+			if event.Type != eventType {
+				t.Fatalf("expected %s event, got: %s", eventType, event.Type)
+			}
+			if event.Object.Object.Key != "event_car" {
+				t.Fatalf("unexpected object: %s", event.Object.Object.Key)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %s event", eventType)
+		}
+	}
+	car := &api.Object{
+		Key:    "event_car",
+		Point:  coorsField,
+		Radius: 10,
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: car}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent(api.EventType_Set)
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"event_car"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent(api.EventType_Delete)
+	car.ExpiresUnix = time.Now().Add(time.Second).Unix()
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: car}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent(api.EventType_Set)
+	expectEvent(api.EventType_Expired)
+}
+
+func TestResumeStream(t *testing.T) {
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"resume_car"}})
+	events := hub.GetClientObjectStream(clientID)
+	for _, point := range []*api.Point{coorsField, pepsiCenter, cherryCreekMall} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "resume_car",
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	var first *api.ObjectEvent
+	select {
+	case first = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a Set event")
+	}
+	hub.RemoveObjectStreamClient(clientID, events)
+	if first.Sequence == 0 {
+		t.Fatal("expected the event to have a sequence")
+	}
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	resp, err := http.Post(srv.URL+"/api/Stream", "application/json", strings.NewReader(fmt.Sprintf(`{"keys": ["resume_car"], "resumeSequence": "%v"}`, first.Sequence)))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	reader := bufio.NewReader(resp.Body)
+	for _, point := range []*api.Point{pepsiCenter, cherryCreekMall} {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		var msg = &api.StreamResponse{}
+		if err := jsonpb.UnmarshalString(line, msg); err != nil {
+			t.Fatal(err.Error())
+		}
+		if msg.Sequence <= first.Sequence {
+			t.Fatalf("expected a sequence greater than %v, got: %v", first.Sequence, msg.Sequence)
+		}
+		if msg.Object.Object.Point.Lat != point.Lat {
+			t.Fatalf("expected the missed updates in order, got: %s", line)
+		}
+	}
+}
+
+func TestSetBatch(t *testing.T) {
+	resp, err := geoDB.SetBatch(context.Background(), &api.SetBatchRequest{
+		Objects: []*api.Object{
+			{
+				Key:    "batch_car_1",
+				Point:  coorsField,
+				Radius: 10,
+			},
+			{
+				Key:   "batch_car_2",
+				Point: pepsiCenter,
+			},
+			{
+				Key:    "batch_car_3",
+				Point:  cherryCreekMall,
+				Radius: 10,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("expected 3 results, got: %v", len(resp.Results))
+	}
+	for i, result := range resp.Results {
+		if i == 1 {
+			if result.Error == "" || result.Object != nil {
+				t.Fatal("expected an error for the object without a radius")
+			}
+			continue
+		}
+		if result.Error != "" {
+			t.Fatal(result.Error)
+		}
+		if result.Object.Object.Key != result.Key {
+			t.Fatalf("expected result %v to be %s, got: %s", i, result.Key, result.Object.Object.Key)
+		}
+	}
+	objects, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "batch_car_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects.Objects) != 2 {
+		t.Fatalf("expected 2 objects, got: %v", len(objects.Objects))
+	}
+}
+
+func TestCreateMetadataIndexConcurrentSet(t *testing.T) {
+	var (
+		wg   = &sync.WaitGroup{}
+		keys []string
+	)
+	for i := 0; i < 20; i++ {
+		keys = append(keys, fmt.Sprintf("metadata_race_car_%v", i))
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+					Object: &api.Object{
+						Key:      key,
+						Point:    coorsField,
+						Radius:   10,
+						Metadata: map[string]string{"race_status": fmt.Sprint((i + j) % 2)},
+					},
+				}); err != nil {
+					t.Error(err.Error())
+					return
+				}
+			}
+		}(i, key)
+	}
+	if _, err := geoDB.CreateMetadataIndex(context.Background(), &api.CreateMetadataIndexRequest{
+		Field: "race_status",
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	wg.Wait()
+	defer geoDB.DeleteMetadataIndex(context.Background(), &api.DeleteMetadataIndexRequest{Fields: []string{"race_status"}})
+	resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "metadata_race_car_",
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{Field: "race_status", Op: api.MetadataOperator_In, Values: []string{"0", "1"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != len(keys) {
+		t.Fatalf("expected every object that was set while the index was created to be indexed, got: %v", len(resp.Objects))
+	}
+}
+
+func TestSetStream(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	body := &strings.Builder{}
+	for _, point := range []*api.Point{coorsField, pepsiCenter, cherryCreekMall} {
+		str, err := (&jsonpb.Marshaler{}).MarshalToString(&api.SetRequest{
+			Object: &api.Object{
+				Key:    "stream_car",
+				Point:  point,
+				Radius: 10,
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		body.WriteString(str + "\n")
+	}
+	resp, err := http.Post(srv.URL+"/api/SetStream", "application/x-ndjson", strings.NewReader(body.String()))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status: %v", resp.StatusCode)
+	}
+	var msg = &api.SetStreamResponse{}
+	if err := jsonpb.Unmarshal(resp.Body, msg); err != nil {
+		t.Fatal(err.Error())
+	}
+	if msg.Set != 3 || msg.Failed != 0 || len(msg.Results) != 0 {
+		t.Fatalf("expected 3 objects to be set, got: %v", msg)
+	}
+	objects, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"stream_car"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	last := objects.Objects["stream_car"]
+	if last == nil || last.Object.Point.Lat != cherryCreekMall.Lat {
+		t.Fatal("expected the objects to be set in order")
+	}
+}
+
+// brokenSetStream is a SetStream server that receives the requests sent on the channel & fails once the channel is closed
+type brokenSetStream struct {
+	grpc.ServerStream
+	requests chan *api.SetRequest
+}
+
+func (s *brokenSetStream) Recv() (*api.SetRequest, error) {
+	r, ok := <-s.requests
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "connection reset")
+	}
+	return r, nil
+}
+
+func (s *brokenSetStream) SendAndClose(*api.SetStreamResponse) error {
+	return nil
+}
+
+func (s *brokenSetStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestSetStreamFlush(t *testing.T) {
+	ss := &brokenSetStream{requests: make(chan *api.SetRequest)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- geoDB.SetStream(ss)
+	}()
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"flush_car_1", "flush_car_2"}})
+	ss.requests <- &api.SetRequest{Object: &api.Object{Key: "flush_car_1", Point: coorsField, Radius: 10}}
+	// the stream is still open- the object is written once the flush interval passes
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		// getting a key that doesn't exist fails
+		if _, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{"flush_car_1"}}); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected a buffered object to be written while the stream is open")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	ss.requests <- &api.SetRequest{Object: &api.Object{Key: "flush_car_2", Point: pepsiCenter, Radius: 10}}
+	close(ss.requests)
+	if err := <-errs; status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected the receive error to be returned, got: %v", err)
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{"flush_car_2"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Objects["flush_car_2"] == nil {
+		t.Fatal("expected buffered objects to be written before the receive error is returned")
+	}
+}
+
+func TestMetadataFilter(t *testing.T) {
+	if _, err := geoDB.CreateMetadataIndex(context.Background(), &api.CreateMetadataIndexRequest{
+		Field: "status",
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	indexes, err := geoDB.ListMetadataIndexes(context.Background(), &api.ListMetadataIndexesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(indexes.Fields) != 1 || indexes.Fields[0] != "status" {
+		t.Fatalf("expected status to be indexed, got: %v", indexes.Fields)
+	}
+	vehicles := []*api.Object{
+		{
+			Key:      "metadata_van_1",
+			Point:    coorsField,
+			Radius:   10,
+			Metadata: map[string]string{"status": "available", "vehicle_type": "van", "capacity": "10"},
+		},
+		{
+			Key:      "metadata_van_2",
+			Point:    coorsField,
+			Radius:   10,
+			Metadata: map[string]string{"status": "busy", "vehicle_type": "van", "capacity": "4"},
+		},
+		{
+			Key:      "metadata_car_1",
+			Point:    pepsiCenter,
+			Radius:   10,
+			Metadata: map[string]string{"status": "available", "vehicle_type": "car"},
+		},
+	}
+	for _, obj := range vehicles {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	availableVans := &api.MetadataFilter{
+		Conditions: []*api.MetadataCondition{
+			{Field: "status", Op: api.MetadataOperator_Equal, Values: []string{"available"}},
+			{Field: "vehicle_type", Op: api.MetadataOperator_Equal, Values: []string{"van"}},
+		},
+	}
+	resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "metadata_",
+		Filter: availableVans,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["metadata_van_1"] == nil {
+		t.Fatalf("expected metadata_van_1, got: %v", len(resp.Objects))
+	}
+	get, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{Field: "status", Op: api.MetadataOperator_In, Values: []string{"available", "busy"}},
+				{Field: "capacity", Op: api.MetadataOperator_GreaterThanOrEqual, Values: []string{"5"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(get.Objects) != 1 || get.Objects["metadata_van_1"] == nil {
+		t.Fatalf("expected metadata_van_1, got: %v", len(get.Objects))
+	}
+	scan, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 5000,
+		},
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{Field: "vehicle_type", Op: api.MetadataOperator_Exists},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scan.Objects) != 3 {
+		t.Fatalf("expected 3 results, got: %v", len(scan.Objects))
+	}
+	vehicles[0].Metadata["status"] = "busy"
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: vehicles[0]}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err = geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "metadata_",
+		Filter: availableVans,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatalf("expected the index to be updated, got: %v", len(resp.Objects))
+	}
+	if _, err := geoDB.DeleteMetadataIndex(context.Background(), &api.DeleteMetadataIndexRequest{
+		Fields: []string{"status"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	regex, err := geoDB.GetRegex(context.Background(), &api.GetRegexRequest{
+		Regex: "^metadata_van",
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{Field: "status", Op: api.MetadataOperator_Equal, Values: []string{"busy"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(regex.Objects) != 2 {
+		t.Fatalf("expected 2 results, got: %v", len(regex.Objects))
+	}
+	if _, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{Field: "capacity", Op: api.MetadataOperator_LessThan, Values: []string{"ten"}},
+			},
+		},
+	}); err == nil {
+		t.Fatal("expected an error for a numeric comparison with a value that isn't a number")
+	}
+}
+
+func TestPagination(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    fmt.Sprintf("page_car_%v", i),
+				Point:  coorsField,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	seen := map[string]struct{}{}
+	pages := 0
+	cursor := ""
+	for {
+		resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+			Prefix: "page_car_",
+			Limit:  2,
+			Cursor: cursor,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		pages++
+		for key := range resp.Objects {
+			if _, ok := seen[key]; ok {
+				t.Fatalf("%s was returned twice", key)
+			}
+			seen[key] = struct{}{}
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	if len(seen) != 5 || pages != 3 {
+		t.Fatalf("expected 5 results in 3 pages, got: %v in %v", len(seen), pages)
+	}
+	keys, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "page_car_",
+		Limit:  2,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	keys, err = geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "page_car_",
+		Limit:  2,
+		Cursor: keys.NextCursor,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keys.Keys) != 2 || keys.Keys[0] != "page_car_2" || keys.Keys[1] != "page_car_3" {
+		t.Fatalf("expected the second page to be page_car_2 & page_car_3, got: %v", keys.Keys)
+	}
+	seen = map[string]struct{}{}
+	cursor = ""
+	for {
+		resp, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+			Bound: &api.Bound{
+				Center: coorsField,
+				Radius: 1000,
+			},
+			Prefix: "page_car_",
+			Limit:  3,
+			Cursor: cursor,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Objects) > 3 {
+			t.Fatalf("expected at most 3 results, got: %v", len(resp.Objects))
+		}
+		for key := range resp.Objects {
+			seen[key] = struct{}{}
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected 5 results, got: %v", len(seen))
+	}
+	if _, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Cursor: "not a cursor!",
+	}); err == nil {
+		t.Fatal("expected an error for an invalid cursor")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"page_car_0", "page_car_1", "page_car_2", "page_car_3", "page_car_4"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestScanStream(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	streamObjects := func(rpc, body string, msg proto.Message) []string {
+		resp, err := http.Post(srv.URL+"/api/"+rpc, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %v", resp.StatusCode)
+		}
+		var lines []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if err := jsonpb.UnmarshalString(scanner.Text(), msg); err != nil {
+				t.Fatal(err.Error())
+			}
+			lines = append(lines, scanner.Text())
+		}
+		return lines
+	}
+	var scan = &api.ScanPrefixBoundStreamResponse{}
+	lines := streamObjects("ScanPrefixBoundStream", `{"bound": {"center": {"lat": 39.756378173828125, "lon": -104.99414825439453}, "radius": 5000}, "prefix": "testing_"}`, scan)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 results, got: %v", len(lines))
+	}
+	var get = &api.GetPrefixStreamResponse{}
+	lines = streamObjects("GetPrefixStream", `{"prefix": "testing_", "limit": 1}`, get)
+	if len(lines) != 1 || get.Object.Object.Key != "testing_coors" || get.Cursor == "" {
+		t.Fatalf("expected testing_coors & a cursor, got: %v", lines)
+	}
+	lines = streamObjects("GetPrefixStream", fmt.Sprintf(`{"prefix": "testing_", "cursor": "%s"}`, get.Cursor), get)
+	if len(lines) != 1 || get.Object.Object.Key != "testing_pepsi_center" {
+		t.Fatalf("expected the stream to continue after testing_coors, got: %v", lines)
+	}
+}
+
+func TestCount(t *testing.T) {
+	for i, fleet := range []string{"north", "north", "south"} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         fmt.Sprintf("count_car_%v", i),
+				Point:       coorsField,
+				Radius:      10,
+				Metadata:    map[string]string{"fleet": fleet},
+				UpdatedUnix: int64(1000 + i),
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.Count(context.Background(), &api.CountRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 1000,
+		},
+		Prefix:  "count_",
+		GroupBy: "fleet",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Total.Count != 3 {
+		t.Fatalf("expected 3 objects, got: %v", resp.Total.Count)
+	}
+	if resp.Groups["north"].GetCount() != 2 || resp.Groups["south"].GetCount() != 1 {
+		t.Fatalf("unexpected groups: %v", resp.Groups)
+	}
+	if resp.Total.MinUpdatedUnix != 1000 || resp.Total.MaxUpdatedUnix != 1002 || resp.Groups["north"].MaxUpdatedUnix != 1001 {
+		t.Fatalf("unexpected updated_unix range: %v", resp.Total)
+	}
+	resp, err = geoDB.Count(context.Background(), &api.CountRequest{
+		Regex: "^count_car_[01]$",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Total.Count != 2 {
+		t.Fatalf("expected 2 objects, got: %v", resp.Total.Count)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"count_car_0", "count_car_1", "count_car_2"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	bound := &api.Bound{
+		Center: coorsField,
+		Radius: 50000,
+	}
+	resp, err := geoDB.Heatmap(context.Background(), &api.HeatmapRequest{
+		Bound:     bound,
+		Precision: 5,
+		Prefix:    "testing_",
+		Centroids: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var count int64
+	for _, cell := range resp.Cells {
+		if len(cell.Cell) != 5 || cell.Centroid == nil {
+			t.Fatalf("unexpected cell: %v", cell)
+		}
+		count += cell.Count
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 objects, got: %v", count)
+	}
+	resp, err = geoDB.Heatmap(context.Background(), &api.HeatmapRequest{
+		Bound:     bound,
+		Zoom:      1,
+		Prefix:    "testing_",
+		Centroids: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Cells) != 1 || resp.Cells[0].Cell != "1/0/0" || resp.Cells[0].Count != 2 {
+		t.Fatalf("expected both objects in tile 1/0/0, got: %v", resp.Cells)
+	}
+	centroid := resp.Cells[0].Centroid
+	if centroid.Lat < pepsiCenter.Lat || centroid.Lat > coorsField.Lat || centroid.Lon < pepsiCenter.Lon || centroid.Lon > coorsField.Lon {
+		t.Fatalf("expected the centroid to be between the objects, got: %v", centroid)
+	}
+}
+
+func TestVectorTile(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	getTile := func(path string) (int, []byte) {
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.StatusCode, body
+	}
+	x, y := geo.ScalarMercator.Project(coorsField.Lon, coorsField.Lat, 12)
+	code, tile := getTile(fmt.Sprintf("/tiles/12/%v/%v.mvt?prefix=testing_", x, y))
+	if code != http.StatusOK {
+		t.Fatalf("unexpected status: %v", code)
+	}
+	if !bytes.Contains(tile, []byte("testing_coors")) || !bytes.Contains(tile, []byte("testing_pepsi_center")) {
+		t.Fatal("expected the tile to contain testing_coors & testing_pepsi_center")
+	}
+	if bytes.Contains(tile, []byte("malls_cherry_creek_mall")) {
+		t.Fatal("expected the prefix to exclude malls_cherry_creek_mall")
+	}
+	code, tile = getTile(fmt.Sprintf("/tiles/12/%v/%v.mvt", x+100, y))
+	if code != http.StatusOK || bytes.Contains(tile, []byte("testing_coors")) {
+		t.Fatalf("expected an empty tile, got: %v", code)
+	}
+	if code, _ = getTile("/tiles/1/2/0.mvt"); code != http.StatusBadRequest {
+		t.Fatalf("expected a bad request for a tile index out of range, got: %v", code)
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/geojson?prefix=testing_")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	collection, err := geojson.UnmarshalFeatureCollection(body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(collection.Features) != 2 {
+		t.Fatalf("expected 2 features, got: %v", len(collection.Features))
+	}
+	for _, feature := range collection.Features {
+		if feature.ID != "testing_coors" && feature.ID != "testing_pepsi_center" {
+			t.Fatalf("unexpected feature: %v", feature.ID)
+		}
+	}
+	features := `{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "geometry": {"type": "Point", "coordinates": [-104.99414825439453, 39.756378173828125]}, "properties": {"name": "geojson_stadium", "capacity": 76125, "open": true}},
+		{"type": "Feature", "geometry": {"type": "Point", "coordinates": [-105.00762176513672, 39.74863815307617]}, "properties": {"name": "geojson_arena", "radius": 150}},
+		{"type": "Feature", "geometry": {"type": "LineString", "coordinates": [[-105, 39], [-104, 40]]}, "properties": {"name": "geojson_road"}}
+	]}`
+	resp, err = http.Post(srv.URL+"/geojson?key_property=name&default_radius=25", "application/geo+json", strings.NewReader(features))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var imported = &api.ImportGeoJSONResponse{}
+	if err := jsonpb.Unmarshal(resp.Body, imported); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+	if len(imported.Results) != 3 || imported.Results[0].Error != "" || imported.Results[1].Error != "" || imported.Results[2].Error == "" {
+		t.Fatalf("expected the line string to fail, got: %v", imported.Results)
+	}
+	stadium := imported.Results[0].Object.Object
+	if stadium.Key != "geojson_stadium" || stadium.Radius != 25 || stadium.Metadata["capacity"] != "76125" || stadium.Metadata["open"] != "true" {
+		t.Fatalf("unexpected object: %v", stadium)
+	}
+	if imported.Results[1].Object.Object.Radius != 150 {
+		t.Fatalf("expected the radius property to be used, got: %v", imported.Results[1].Object.Object.Radius)
+	}
+	exported, err := geoDB.ExportGeoJSON(context.Background(), &api.ExportGeoJSONRequest{
+		Prefix: "geojson_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"geojson_stadium", "geojson_arena"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	reimported, err := geoDB.ImportGeoJSON(context.Background(), &api.ImportGeoJSONRequest{
+		FeatureCollection: exported.FeatureCollection,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, result := range reimported.Results {
+		if result.Error != "" {
+			t.Fatal(result.Error)
+		}
+		if result.Key == "geojson_stadium" && (result.Object.Object.Metadata["capacity"] != "76125" || result.Object.Object.Radius != 25) {
+			t.Fatalf("expected the export to round trip, got: %v", result.Object.Object)
+		}
+	}
+	if len(reimported.Results) != 2 {
+		t.Fatalf("expected 2 results, got: %v", len(reimported.Results))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"geojson_stadium", "geojson_arena"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	srv := grpc.NewServer()
+	api.RegisterGeoDBServer(srv, geoDB)
+	go srv.Serve(lis)
+	defer srv.Stop()
+	rows := `id,lat,lon,radius,status,expires_unix
+csv_truck_1,39.756378173828125,-104.99414825439453,50,available,
+csv_truck_2,not-a-lat,-104.99414825439453,50,busy,
+csv_truck_3,39.74863815307617,-105.00762176513672,,busy,
+csv_truck_4,39.74863815307617,-105.00762176513672,20,bu"sy,
+csv_truck_5,39.71670913696289,-104.95344543457031,30,available,
+`
+	conn, ctx, err := dial(lis.Addr().String())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	stream, err := api.NewGeoDBClient(conn).ImportCSV(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	//split the file mid-row to make sure chunks are reassembled
+	for _, chunk := range []string{rows[:60], rows[60:]} {
+		if err := stream.Send(&api.ImportCSVRequest{Chunk: []byte(chunk)}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Imported != 2 || resp.Failed != 3 || len(resp.Errors) != 3 {
+		t.Fatalf("expected 2 imported rows & 3 row errors, got: %v", resp)
+	}
+	for i, row := range []int64{3, 4, 5} {
+		if resp.Errors[i].Row != row {
+			t.Fatalf("expected row %v to fail, got: %v", row, resp.Errors[i])
+		}
+	}
+	get, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "csv_truck_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(get.Objects) != 2 || get.Objects["csv_truck_1"].Object.Metadata["status"] != "available" {
+		t.Fatalf("expected 2 imported objects with metadata, got: %v", len(get.Objects))
+	}
+	file, err := ioutil.TempFile("", "geodb-*.csv")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString("key,latitude,longitude\ncsv_truck_6,39.74626922607422,-104.97151184082031\n"); err != nil {
+		t.Fatal(err.Error())
+	}
+	file.Close()
+	if err := importCSV([]string{"-address", lis.Addr().String(), "-key-column", "key", "-lat-column", "latitude", "-lon-column", "longitude", "-default-radius", "15", file.Name()}); err != nil {
+		t.Fatal(err.Error())
+	}
+	imported, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"csv_truck_6"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if imported.Objects["csv_truck_6"].Object.Radius != 15 {
+		t.Fatal("expected csv_truck_6 to be imported with the default radius")
+	}
+	// rows without a radius are valid without the -default-radius flag
+	if err := ioutil.WriteFile(file.Name(), []byte("id,lat,lon\ncsv_truck_7,39.74626922607422,-104.97151184082031\n"), 0644); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := importCSV([]string{"-address", lis.Addr().String(), file.Name()}); err != nil {
+		t.Fatal(err.Error())
+	}
+	imported, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"csv_truck_7"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if imported.Objects["csv_truck_7"] == nil || imported.Objects["csv_truck_7"].Object.Radius != 1 {
+		t.Fatal("expected csv_truck_7 to be imported with a radius of 1")
+	}
+	// only the errors of the first 1000 failed rows are returned
+	invalid := &bytes.Buffer{}
+	invalid.WriteString("id,lat,lon\n")
+	for i := 0; i < 1500; i++ {
+		fmt.Fprintf(invalid, "csv_invalid_%v,not-a-lat,-104.97151184082031\n", i)
+	}
+	stream, err = api.NewGeoDBClient(conn).ImportCSV(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := stream.Send(&api.ImportCSVRequest{Chunk: invalid.Bytes()}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err = stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Failed != 1500 || len(resp.Errors) != 1000 || resp.Errors[999].Row != 1001 {
+		t.Fatalf("expected 1500 failed rows & the errors of the first 1000, got: %v failed & %v errors", resp.Failed, len(resp.Errors))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"csv_truck_1", "csv_truck_5", "csv_truck_6", "csv_truck_7"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestImportTrace(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	srv := grpc.NewServer()
+	api.RegisterGeoDBServer(srv, geoDB)
+	go srv.Serve(lis)
+	defer srv.Stop()
+	conn, ctx, err := dial(lis.Addr().String())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	client := api.NewGeoDBClient(conn)
+	replay := func(req *api.ImportTraceRequest) []*api.ImportTraceResponse {
+		stream, err := client.ImportTrace(ctx, req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		var updates []*api.ImportTraceResponse
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return updates
+			}
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			updates = append(updates, resp)
+		}
+	}
+	//the second track was recorded first
+	gpx := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk><name>second</name><trkseg>
+    <trkpt lat="%v" lon="%v"><time>2020-01-01T00:02:00Z</time></trkpt>
+  </trkseg></trk>
+  <trk><name>first</name><trkseg>
+    <trkpt lat="%v" lon="%v"><time>2020-01-01T00:00:00Z</time></trkpt>
+    <trkpt lat="%v" lon="%v"><time>2020-01-01T00:01:00Z</time></trkpt>
+  </trkseg></trk>
+</gpx>`, saintJosephHospital.Lat, saintJosephHospital.Lon, coorsField.Lat, coorsField.Lon, pepsiCenter.Lat, pepsiCenter.Lon)
+	updates := replay(&api.ImportTraceRequest{
+		Key:    "trace_gpx",
+		Format: api.TraceFormat_GPX,
+		Trace:  gpx,
+		Radius: 10,
+	})
+	if len(updates) != 3 {
+		t.Fatalf("expected 3 updates, got: %v", len(updates))
+	}
+	for i, update := range updates {
+		recorded := time.Date(2020, 1, 1, 0, i, 0, 0, time.UTC).Unix()
+		if update.RecordedUnix != recorded || update.Object.Object.UpdatedUnix != recorded {
+			t.Fatalf("expected update %v to be recorded at %v, got: %v", i, recorded, update)
+		}
+	}
+	trajectory, err := geoDB.GetTrajectory(context.Background(), &api.GetTrajectoryRequest{
+		Key: "trace_gpx",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(trajectory.Objects) != 3 || trajectory.Objects[2].Point.Lat != saintJosephHospital.Lat {
+		t.Fatalf("expected the trace to be imported as history, got: %v", trajectory.Objects)
+	}
+	kml := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2" xmlns:gx="http://www.google.com/kml/ext/2.2">
+  <Document><Folder><Placemark>
+    <gx:Track>
+      <when>2020-01-01T00:00:00Z</when>
+      <when>2020-01-01T00:00:10Z</when>
+      <when>2020-01-01T00:00:20Z</when>
+      <gx:coord>%v %v 0</gx:coord>
+      <gx:coord>%v %v 0</gx:coord>
+      <gx:coord>%v %v 0</gx:coord>
+    </gx:Track>
+  </Placemark></Folder></Document>
+</kml>`, coorsField.Lon, coorsField.Lat, pepsiCenter.Lon, pepsiCenter.Lat, cherryCreekMall.Lon, cherryCreekMall.Lat)
+	start := time.Now()
+	updates = replay(&api.ImportTraceRequest{
+		Key:    "trace_kml",
+		Format: api.TraceFormat_KML,
+		Trace:  kml,
+		Radius: 10,
+		Speed:  100,
+	})
+	//20 seconds replayed 100x faster
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected the replay to take at least 200ms, took: %v", elapsed)
+	}
+	if len(updates) != 3 || updates[2].Object.Object.Point.Lat != cherryCreekMall.Lat || updates[2].Object.Object.UpdatedUnix < start.Unix() {
+		t.Fatalf("expected 3 live updates ending at cherry creek mall, got: %v", updates)
+	}
+	empty, err := client.ImportTrace(ctx, &api.ImportTraceRequest{
+		Key:    "trace_kml",
+		Format: api.TraceFormat_KML,
+		Trace:  "<kml></kml>",
+		Radius: 10,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := empty.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a trace without points to be invalid, got: %v", err)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"trace_gpx", "trace_kml"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestBackup(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	srv := grpc.NewServer()
+	api.RegisterGeoDBServer(srv, geoDB)
+	go srv.Serve(lis)
+	defer srv.Stop()
+	dir, err := ioutil.TempDir("", "geodb-backup")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	set := func(key string, point *api.Point) {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	set("backup_van", coorsField)
+	conn, ctx, err := dial(lis.Addr().String())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	stream, err := api.NewGeoDBClient(conn).Backup(ctx, &api.BackupRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	full := bytes.NewBuffer(nil)
+	var since uint64
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		full.Write(resp.Chunk)
+		since = resp.Since
+	}
+	if full.Len() == 0 || since == 0 {
+		t.Fatalf("expected a full backup & the version of the next incremental backup, got: %v bytes, since: %v", full.Len(), since)
+	}
+	if err := ioutil.WriteFile(dir+"/full.bak", full.Bytes(), 0600); err != nil {
+		t.Fatal(err.Error())
+	}
+	set("backup_van", pepsiCenter)
+	set("backup_truck", cherryCreekMall)
+	if err := backup([]string{"-address", lis.Addr().String(), "-since", fmt.Sprint(since), dir + "/incremental.bak"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := restore([]string{"-path", dir + "/data", dir + "/full.bak", dir + "/incremental.bak"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := restore([]string{"-path", dir + "/data", dir + "/full.bak"}); err == nil {
+		t.Fatal("expected restoring into a non-empty data directory to fail")
+	}
+	restored, err := server.OpenDB(dir + "/data")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer restored.Close()
+	objects, _, err := geodb.Get(restored, []string{"backup_van", "backup_truck"}, nil, geodb.Page{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 2 || objects["backup_van"].Object.Point.Lat != pepsiCenter.Lat {
+		t.Fatalf("expected the full & incremental backups to be restored, got: %v", objects)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"backup_van", "backup_truck"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestReindexUpgrade(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geodb-upgrade")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	db, err := server.OpenDB(dir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer db.Close()
+	// an object written by a version without geohash or expiry index entries
+	expires := time.Now().Unix() + 2
+	bits, err := proto.Marshal(&api.ObjectDetail{
+		Object: &api.Object{
+			Key:         "upgrade_car",
+			Point:       coorsField,
+			Radius:      10,
+			ExpiresUnix: expires,
+			UpdatedUnix: time.Now().Unix(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := db.Update(func(txn *badger.Txn) error {
+		return txn.SetEntry(&badger.Entry{
+			Key:       []byte("upgrade_car"),
+			Value:     bits,
+			UserMeta:  1, //objectMeta
+			ExpiresAt: uint64(expires),
+		})
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := geodb.ReindexGeohash(db); err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, _, err := geodb.ScanBound(db, &api.Bound{Center: coorsField, Radius: 100}, nil, nil, geodb.Page{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := objects["upgrade_car"]; !ok {
+		t.Fatalf("expected the reindexed object to be found by a bound scan, got: %v", objects)
+	}
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"upgrade_car"}})
+	events := hub.GetClientObjectStream(clientID)
+	defer hub.RemoveObjectStreamClient(clientID, events)
+	time.Sleep(time.Until(time.Unix(expires+1, 0)))
+	if err := geodb.ExpireObjects(db, hub); err != nil {
+		t.Fatal(err.Error())
+	}
+	select {
+	case event := <-events:
+		if event.Type != api.EventType_Expired {
+			t.Fatalf("expected an expired event, got: %s", event.Type)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the reindexed object to publish an expired event")
+	}
+}
+
+func TestSequenceRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geodb-sequence")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	var sequences []uint64
+	for i := 0; i < 2; i++ {
+		db, err := server.OpenDB(dir)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if _, err := geodb.Set(db, nil, hub, &api.Object{
+			Key:    "sequence_car",
+			Point:  coorsField,
+			Radius: 10,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := geodb.ReplayChangeLog(db, 0, 0, func(event *api.ObjectEvent) error {
+			sequences = append(sequences, event.Sequence)
+			return nil
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// the first replay returns the first event, the second replay both events
+	if len(sequences) != 3 || sequences[2] <= sequences[1] {
+		t.Fatalf("expected the change log sequence to keep increasing after a restart, got: %v", sequences)
+	}
+}
+
+func TestChangeLogCommitOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geodb-changelog")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	db, err := server.OpenDB(dir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer db.Close()
+	var (
+		wg   = &sync.WaitGroup{}
+		done = make(chan struct{})
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 4; j++ {
+				if _, err := geodb.Set(db, nil, hub, &api.Object{
+					Key:    fmt.Sprintf("commit_order_car_%v", i),
+					Point:  coorsField,
+					Radius: 10,
+				}); err != nil {
+					t.Error(err.Error())
+					return
+				}
+			}
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	// the writers don't conflict, so a replay must never see a sequence before the ones preceding it have been committed
+	for {
+		var finished bool
+		select {
+		case <-done:
+			finished = true
+		default:
+		}
+		var sequences []uint64
+		if err := geodb.ReplayChangeLog(db, 0, 0, func(event *api.ObjectEvent) error {
+			sequences = append(sequences, event.Sequence)
+			return nil
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		for i, seq := range sequences {
+			if seq != uint64(i+1) {
+				t.Fatalf("expected the change log to be committed in sequence order, got sequence %v at position %v", seq, i+1)
+			}
+		}
+		if finished {
+			if len(sequences) != 200 {
+				t.Fatalf("expected 200 change log events, got: %v", len(sequences))
+			}
+			return
+		}
+	}
+}
+
+func TestConcurrentSet(t *testing.T) {
+	var (
+		wg   = &sync.WaitGroup{}
+		errs = make(chan error, 50)
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+				Object: &api.Object{
+					Key:    "concurrent_van",
+					Point:  &api.Point{Lat: coorsField.Lat + float64(i)*0.0001, Lon: coorsField.Lon},
+					Radius: 10,
+				},
+			}); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("expected concurrent sets of the same key to succeed, got: %s", err.Error())
+	}
+	resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "concurrent_van",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 {
+		t.Fatalf("expected 1 object, got: %v", len(resp.Objects))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"concurrent_van"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGeofenceExit(t *testing.T) {
+	if _, err := geoDB.CreateGeofence(context.Background(), &api.CreateGeofenceRequest{
+		Geofence: &api.Geofence{
+			Name: "exit_zone",
+			Bound: &api.Bound{
+				Center: coorsField,
+				Radius: 500,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.DeleteGeofence(context.Background(), &api.DeleteGeofenceRequest{
+		Names: []string{"exit_zone"},
+	})
+	clientID := hub.AddGeofenceStreamClient("", &stream.GeofenceFilter{Names: []string{"exit_zone"}})
+	events := hub.GetClientGeofenceStream(clientID)
+	defer hub.RemoveGeofenceStreamClient(clientID, events)
+	expectEvent := func(key string, transition api.Transition) {
+		for {
+			select {
+			case event := <-events:
+				// objects of other tests that are inside of the geofence enter it once it is created
+				if event.Object.Key != key && event.Transition == api.Transition_Enter {
+					continue
+				}
+				if event.Object.Key != key || event.Transition != transition {
+					t.Fatalf("expected %s %s event, got: %v", key, transition, event)
+				}
+				return
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected %s %s event", key, transition)
+			}
+		}
+	}
+	car := &api.Object{
+		Key:    "exit_car",
+		Point:  coorsField,
+		Radius: 10,
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: car}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent("exit_car", api.Transition_Enter)
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"exit_car"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent("exit_car", api.Transition_Exit)
+	resp, err := geoDB.GetGeofenceEvents(context.Background(), &api.GetGeofenceEventsRequest{
+		Geofence: "exit_zone",
+		Key:      "exit_car",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Events) != 2 || resp.Events[1].Transition != api.Transition_Exit {
+		t.Fatalf("expected the exit of the deleted object to be persisted, got: %v", resp.Events)
+	}
+	car.Key = "exit_expiring_car"
+	car.ExpiresUnix = time.Now().Add(time.Second).Unix()
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: car}); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectEvent("exit_expiring_car", api.Transition_Enter)
+	expectEvent("exit_expiring_car", api.Transition_Exit)
+}
+
+func TestConcurrentTracker(t *testing.T) {
+	target := &api.Object{
+		Key:    "concurrent_target",
+		Point:  pepsiCenter,
+		Radius: 10,
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: target}); err != nil {
+		t.Fatal(err.Error())
+	}
+	var (
+		wg   = &sync.WaitGroup{}
+		errs = make(chan error, 50)
+	)
+	for i := 0; i < 25; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+				Object: &api.Object{
+					Key:    "concurrent_target",
+					Point:  coorsField,
+					Radius: 10,
+				},
+			}); err != nil {
+				errs <- err
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+				Object: &api.Object{
+					Key:    "concurrent_tracker",
+					Point:  coorsField,
+					Radius: 10,
+					Tracking: &api.ObjectTracking{
+						Trackers: []*api.ObjectTracker{
+							{
+								TargetObjectKey: "concurrent_target",
+							},
+						},
+					},
+				},
+			}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("expected sets of tracking objects & their targets not to conflict, got: %s", err.Error())
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"concurrent_tracker"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if events := resp.Objects["concurrent_tracker"].TrackerEvents; len(events) != 1 {
+		t.Fatalf("expected 1 tracker event, got: %v", events)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"concurrent_target", "concurrent_tracker"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestHistorySameSecond(t *testing.T) {
+	for _, point := range []*api.Point{coorsField, pepsiCenter, cherryCreekMall} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         "history_burst",
+				Point:       point,
+				Radius:      10,
+				UpdatedUnix: 1000,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	trajectory, err := geoDB.GetTrajectory(context.Background(), &api.GetTrajectoryRequest{
+		Key: "history_burst",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(trajectory.Objects) != 3 {
+		t.Fatalf("expected every sample within the same second to be kept, got: %v", len(trajectory.Objects))
+	}
+	resp, err := geoDB.GetPointAt(context.Background(), &api.GetPointAtRequest{
+		Key:           "history_burst",
+		TimestampUnix: 1000,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Interpolated || resp.Point.Lat != cherryCreekMall.Lat {
+		t.Fatal("expected the latest sample of the second")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"history_burst"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestPointAtAntimeridian(t *testing.T) {
+	for i, lon := range []float64{179, -179} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         "antimeridian_ship",
+				Point:       &api.Point{Lat: 10, Lon: lon},
+				Radius:      10,
+				UpdatedUnix: int64(1000 + i*10),
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// the ship crosses the antimeridian instead of travelling 358 degrees west
+	for timestamp, lon := range map[int64]float64{1002: 179.4, 1005: 180, 1008: -179.4} {
+		resp, err := geoDB.GetPointAt(context.Background(), &api.GetPointAtRequest{
+			Key:           "antimeridian_ship",
+			TimestampUnix: timestamp,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !resp.Interpolated || resp.Point.Lat != 10 || math.Abs(resp.Point.Lon-lon) > 1e-9 {
+			t.Fatalf("expected lon %v at %v, got: %v", lon, timestamp, resp.Point.Lon)
+		}
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"antimeridian_ship"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamErrorStatus(t *testing.T) {
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/sse/StreamRegex?regex=" + url.QueryEscape("("))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest || strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		t.Fatalf("expected an invalid regex to fail the server-sent event request, got: %v %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	resp, err = http.Post(srv.URL+"/api/ScanBoundStream", "application/json", strings.NewReader(fmt.Sprintf(`{"bound":{"center":{"lat":%v,"lon":%v},"radius":1000},"cursor":"!!!"}`, coorsField.Lat, coorsField.Lon)))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected an invalid cursor to fail the stream request, got: %v", resp.StatusCode)
+	}
+	resp, err = http.Post(srv.URL+"/api/ScanBoundStream", "application/json", strings.NewReader(`{"bound":{"center":{"lat":0,"lon":0},"radius":1}}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("expected an empty stream to succeed, got: %v %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+}
+
+func TestSSEAuth(t *testing.T) {
+	config.Config.Set("GEODB_PASSWORD", "sse-secret")
+	defer config.Config.Set("GEODB_PASSWORD", nil)
+	config.Config.Set("GEODB_SSE_TOKEN", "sse-token")
+	defer config.Config.Set("GEODB_SSE_TOKEN", nil)
+	router := echo.New()
+	gateway.Register(router, geoDB)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	get := func(url string, setAuth func(req *http.Request)) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if setAuth != nil {
+			setAuth(req)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		resp.Body.Close()
+		return resp
+	}
+	resp := get(srv.URL+"/sse/Stream?keys=sse_auth_car", nil)
+	if resp.StatusCode != http.StatusUnauthorized || resp.Header.Get("WWW-Authenticate") == "" {
+		t.Fatalf("expected browsers to be asked for credentials, got: %v", resp.StatusCode)
+	}
+	resp = get(srv.URL+"/sse/Stream?keys=sse_auth_car", func(req *http.Request) {
+		req.SetBasicAuth("browser", "sse-secret")
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected standard basic auth to be accepted, got: %v", resp.StatusCode)
+	}
+	resp = get(srv.URL+"/sse/Stream?keys=sse_auth_car&token=sse-token", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the token query parameter to be accepted, got: %v", resp.StatusCode)
+	}
+	resp = get(srv.URL+"/sse/Stream?keys=sse_auth_car&token=sse-secret", nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the password to be rejected as a token, got: %v", resp.StatusCode)
+	}
+	resp = get(srv.URL+"/sse/Stream?keys=sse_auth_car&token=wrong", nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an invalid token to be rejected, got: %v", resp.StatusCode)
+	}
+	resp = get(srv.URL+"/sse/Stream?keys=sse_auth_car", func(req *http.Request) {
+		req.Header.Set("Authorization", "basic sse-secret")
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the geodb basic auth header to be accepted, got: %v", resp.StatusCode)
+	}
+	resp, err := http.Post(srv.URL+"/api/Ping?token=sse-token", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the token query parameter to be limited to server-sent events, got: %v", resp.StatusCode)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"testing_pepsi_center"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "testing_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Keys) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	if _, err := geoDB.CreateGeofence(context.Background(), &api.CreateGeofenceRequest{
+		Geofence: &api.Geofence{
+			Name: "delete_all_fence",
+			Bound: &api.Bound{
+				Center: coorsField,
+				Radius: 100,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.CreateMetadataIndex(context.Background(), &api.CreateMetadataIndexRequest{
+		Field: "delete_all_field",
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	clientID := hub.AddObjectStreamClient("", &stream.ObjectFilter{Keys: []string{"delete_all_van"}})
+	events := hub.GetClientObjectStream(clientID)
+	defer hub.RemoveObjectStreamClient(clientID, events)
+	nextSequence := func() uint64 {
+		select {
+		case event := <-events:
+			return event.Sequence
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an object event")
+		}
+		return 0
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "delete_all_van",
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"*"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	set, deleted := nextSequence(), nextSequence()
+	if set == 0 || deleted <= set {
+		t.Fatalf("expected increasing sequences, got: %v, %v", set, deleted)
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+	scan, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 1000,
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scan.Objects) != 0 {
+		t.Fatal("expected the geohash index to be empty")
+	}
+	fences, err := geoDB.ListGeofences(context.Background(), &api.ListGeofencesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var found bool
+	for _, fence := range fences.Geofences {
+		found = found || fence.Name == "delete_all_fence"
+	}
+	if !found {
+		t.Fatal("expected geofences to be kept")
+	}
+	indexes, err := geoDB.ListMetadataIndexes(context.Background(), &api.ListMetadataIndexesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(indexes.Fields) == 0 {
+		t.Fatal("expected metadata index declarations to be kept")
+	}
+	trajectory, err := geoDB.GetTrajectory(context.Background(), &api.GetTrajectoryRequest{
+		Key: "delete_all_van",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(trajectory.Objects) == 0 {
+		t.Fatal("expected history to be kept")
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "delete_all_van",
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if seq := nextSequence(); seq <= deleted {
+		t.Fatalf("expected the change log sequence to keep increasing after deleting all objects, got: %v after %v", seq, deleted)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"delete_all_van"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteGeofence(context.Background(), &api.DeleteGeofenceRequest{
+		Names: []string{"delete_all_fence"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteMetadataIndex(context.Background(), &api.DeleteMetadataIndexRequest{
+		Fields: []string{"delete_all_field"},
+	}); err != nil {
+		t.Fatal(err.Error())
 	}
 }
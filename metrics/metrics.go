@@ -6,7 +6,7 @@ import (
 )
 
 func init() {
-	prometheus.MustRegister(objectLat, objectLon)
+	prometheus.MustRegister(objectLat, objectLon, streamQueueDepth, streamDropped, streamDisconnected)
 }
 
 var (
@@ -18,9 +18,35 @@ var (
 		Name: "object_longitude",
 		Help: "the objects longitude",
 	}, []string{"key"})
+	// the stream metrics are labelled with the overflow policy rather than the client id so that their cardinality stays bounded
+	streamQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stream_queue_depth",
+		Help: "the number of messages waiting to be delivered to the stream clients",
+	}, []string{"policy"})
+	streamDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_dropped_total",
+		Help: "the number of messages dropped because a stream clients queue was full",
+	}, []string{"policy"})
+	streamDisconnected = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "stream_disconnected_total",
+		Help: "the number of stream clients disconnected because their queue was full",
+	})
 )
 
 func GaugeObjectLocation(key string, point *api.Point) {
 	objectLat.WithLabelValues(key).Set(point.Lat)
 	objectLon.WithLabelValues(key).Set(point.Lon)
 }
+
+// AddStreamQueueDepth adds delta to the number of queued messages of the stream clients with the overflow policy
+func AddStreamQueueDepth(policy string, delta int) {
+	streamQueueDepth.WithLabelValues(policy).Add(float64(delta))
+}
+
+func IncStreamDropped(policy string) {
+	streamDropped.WithLabelValues(policy).Inc()
+}
+
+func IncStreamDisconnected() {
+	streamDisconnected.Inc()
+}
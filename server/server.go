@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/autom8ter/geodb/auth"
 	"github.com/autom8ter/geodb/config"
+	geodb "github.com/autom8ter/geodb/db"
 	"github.com/autom8ter/geodb/maps"
 	"github.com/autom8ter/geodb/stream"
 	"github.com/dgraph-io/badger/v2"
@@ -42,6 +43,10 @@ func (s *Server) GetGRPCServer() *grpc.Server {
 	return s.server
 }
 
+func (s *Server) GetRouter() *echo.Echo {
+	return s.router
+}
+
 func (s *Server) GetDB() *badger.DB {
 	return s.db
 }
@@ -65,12 +70,24 @@ func (s *Server) GetGmaps() *maps.Client {
 	return s.gmaps
 }
 
+// OpenDB opens the database in the data directory at path
+func OpenDB(path string) (*badger.DB, error) {
+	return badger.Open(badger.DefaultOptions(path))
+}
+
 func GetDeps() (*badger.DB, *stream.Hub, *maps.Client, error) {
-	db, err := badger.Open(badger.DefaultOptions(config.Config.GetString("GEODB_PATH")))
+	db, err := OpenDB(config.Config.GetString("GEODB_PATH"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := geodb.ReindexGeohash(db); err != nil {
+		return nil, nil, nil, err
+	}
+	policy, err := stream.ParseOverflowPolicy(config.Config.GetString("GEODB_STREAM_OVERFLOW_POLICY"))
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	hub := stream.NewHub()
+	hub := stream.NewHub(config.Config.GetInt("GEODB_STREAM_BUFFER_SIZE"), policy)
 	if config.Config.IsSet("GEODB_GMAPS_KEY") {
 		client, err := maps.NewClient(db, config.Config.GetString("GEODB_GMAPS_KEY"), config.Config.GetDuration("GEODB_GMAPS_CACHE_DURATION"))
 		if err != nil {
@@ -78,7 +95,7 @@ func GetDeps() (*badger.DB, *stream.Hub, *maps.Client, error) {
 		}
 		return db, hub, client, err
 	}
-	return db, stream.NewHub(), nil, nil
+	return db, hub, nil, nil
 }
 
 func NewServer() (*Server, error) {
@@ -94,14 +111,14 @@ func NewServer() (*Server, error) {
 		grpc_ctxtags.UnaryServerInterceptor(),
 		promInterceptor.UnaryServer(),
 		grpc_logrus.UnaryServerInterceptor(log.NewEntry(log.New())),
-		grpc_validator.UnaryServerInterceptor(),
+		skipBatchValidation(grpc_validator.UnaryServerInterceptor()),
 		grpc_auth.UnaryServerInterceptor(auth.BasicAuthFunc()),
 		grpc_recovery.UnaryServerInterceptor(),
 	)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_ctxtags.StreamServerInterceptor(),
 			promInterceptor.StreamServer(),
-			grpc_validator.StreamServerInterceptor(),
+			skipBatchStreamValidation(grpc_validator.StreamServerInterceptor()),
 			grpc_auth.StreamServerInterceptor(auth.BasicAuthFunc()),
 			grpc_recovery.StreamServerInterceptor(),
 		)),
@@ -124,6 +141,30 @@ func NewServer() (*Server, error) {
 	return s, nil
 }
 
+// batchMethods validate each object individually so that a single invalid object doesn't fail the whole batch
+var batchMethods = map[string]bool{
+	"/api.GeoDB/SetBatch":  true,
+	"/api.GeoDB/SetStream": true,
+}
+
+func skipBatchValidation(validator grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if batchMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		return validator(ctx, req, info, handler)
+	}
+}
+
+func skipBatchStreamValidation(validator grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if batchMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		return validator(srv, ss, info, handler)
+	}
+}
+
 func (s *Server) Run() {
 	lis, err := net.Listen("tcp", config.Config.GetString("GEODB_PORT"))
 	if err != nil {
@@ -142,6 +183,12 @@ func (s *Server) Run() {
 	egp.Go(func() error {
 		return s.streamHub.StartObjectStream(ctx)
 	})
+	egp.Go(func() error {
+		return s.streamHub.StartGeofenceStream(ctx)
+	})
+	egp.Go(func() error {
+		return geodb.WatchExpirations(ctx, s.db, s.streamHub, config.Config.GetDuration("GEODB_EXPIRY_INTERVAL"))
+	})
 	egp.Go(func() error {
 		for {
 			time.Sleep(config.Config.GetDuration("GEODB_GC_INTERVAL"))
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"bufio"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+)
+
+// backupChunkSize is the max number of bytes of a backup that are sent per message
+const backupChunkSize = 64 * 1024
+
+func (p *GeoDB) Backup(r *api.BackupRequest, ss api.GeoDB_BackupServer) error {
+	w := bufio.NewWriterSize(backupChunkWriter{ss}, backupChunkSize)
+	since, err := db.Backup(p.db, w, r.Since)
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return ss.Send(&api.BackupResponse{
+		Since: since,
+	})
+}
+
+// backupChunkWriter sends writes as chunks of up to backupChunkSize bytes of a Backup stream
+type backupChunkWriter struct {
+	ss api.GeoDB_BackupServer
+}
+
+func (w backupChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := written + backupChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		if err := w.ss.Send(&api.BackupResponse{Chunk: p[written:end]}); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
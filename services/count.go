@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) Count(ctx context.Context, r *api.CountRequest) (*api.CountResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	total, groups, err := db.Count(p.db, r.Bound, r.Polygon, r.Keys, r.Prefix, r.Regex, r.Filter, r.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CountResponse{
+		Total:  total,
+		Groups: groups,
+	}, nil
+}
+
+func (p *GeoDB) Heatmap(ctx context.Context, r *api.HeatmapRequest) (*api.HeatmapResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cells, err := db.Heatmap(p.db, r.Bound, int(r.Precision), int(r.Zoom), r.Prefix, r.Filter, r.Centroids)
+	if err != nil {
+		return nil, err
+	}
+	return &api.HeatmapResponse{
+		Cells: cells,
+	}, nil
+}
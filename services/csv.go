@@ -0,0 +1,58 @@
+package services
+
+import (
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+func (p *GeoDB) ImportCSV(ss api.GeoDB_ImportCSVServer) error {
+	first, err := ss.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "at least one chunk is required")
+		}
+		return err
+	}
+	if err := first.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	imported, failed, rowErrors, err := db.ImportCSV(p.db, p.gmaps, p.hub, &csvChunkReader{ss: ss, buf: first.Chunk}, helpers.CSVMapping{
+		KeyColumn:     first.KeyColumn,
+		LatColumn:     first.LatColumn,
+		LonColumn:     first.LonColumn,
+		RadiusColumn:  first.RadiusColumn,
+		ExpiresColumn: first.ExpiresColumn,
+		DefaultRadius: first.DefaultRadius,
+	})
+	if err != nil {
+		return err
+	}
+	return ss.SendAndClose(&api.ImportCSVResponse{
+		Imported: imported,
+		Errors:   rowErrors,
+		Failed:   failed,
+	})
+}
+
+// csvChunkReader reads the chunks of a csv file from an ImportCSV stream as they are received
+type csvChunkReader struct {
+	ss  api.GeoDB_ImportCSVServer
+	buf []byte
+}
+
+func (r *csvChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.ss.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) CreateGeofence(ctx context.Context, r *api.CreateGeofenceRequest) (*api.CreateGeofenceResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fence, err := db.CreateGeofence(p.db, p.hub, r.Geofence)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CreateGeofenceResponse{
+		Geofence: fence,
+	}, nil
+}
+
+func (p *GeoDB) DeleteGeofence(ctx context.Context, r *api.DeleteGeofenceRequest) (*api.DeleteGeofenceResponse, error) {
+	if err := db.DeleteGeofence(p.db, r.Names); err != nil {
+		return nil, err
+	}
+	return &api.DeleteGeofenceResponse{}, nil
+}
+
+func (p *GeoDB) ListGeofences(ctx context.Context, r *api.ListGeofencesRequest) (*api.ListGeofencesResponse, error) {
+	fences, err := db.ListGeofences(p.db)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListGeofencesResponse{
+		Geofences: fences,
+	}, nil
+}
+
+func (p *GeoDB) GetGeofenceEvents(ctx context.Context, r *api.GetGeofenceEventsRequest) (*api.GetGeofenceEventsResponse, error) {
+	events, err := db.GetGeofenceEvents(p.db, r.Geofence, r.Key, r.FromUnix, r.ToUnix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetGeofenceEventsResponse{
+		Events: events,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) ExportGeoJSON(ctx context.Context, r *api.ExportGeoJSONRequest) (*api.ExportGeoJSONResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	collection, err := db.ExportGeoJSON(p.db, r.Bound, r.Polygon, r.Keys, r.Prefix, r.Regex, r.Filter, r.TrackerEvents)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ExportGeoJSONResponse{
+		FeatureCollection: string(collection),
+	}, nil
+}
+
+func (p *GeoDB) ImportGeoJSON(ctx context.Context, r *api.ImportGeoJSONRequest) (*api.ImportGeoJSONResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	results, err := db.ImportGeoJSON(p.db, p.gmaps, p.hub, []byte(r.FeatureCollection), helpers.FeatureMapping{
+		KeyProperty:        r.KeyProperty,
+		RadiusProperty:     r.RadiusProperty,
+		DefaultRadius:      r.DefaultRadius,
+		MetadataProperties: r.MetadataProperties,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ImportGeoJSONResponse{
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) GetTrajectory(ctx context.Context, r *api.GetTrajectoryRequest) (*api.GetTrajectoryResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, err := db.GetTrajectory(p.db, r.Key, r.FromUnix, r.ToUnix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTrajectoryResponse{
+		Objects: objects,
+	}, nil
+}
+
+func (p *GeoDB) GetPointAt(ctx context.Context, r *api.GetPointAtRequest) (*api.GetPointAtResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	point, interpolated, err := db.GetPointAt(p.db, r.Key, r.TimestampUnix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetPointAtResponse{
+		Point:        point,
+		Interpolated: interpolated,
+	}, nil
+}
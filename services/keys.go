@@ -7,23 +7,34 @@ import (
 )
 
 func (p *GeoDB) GetKeys(ctx context.Context, r *api.GetKeysRequest) (*api.GetKeysResponse, error) {
+	keys, next, err := db.GetKeys(p.db, db.Page{Limit: r.Limit, Cursor: r.Cursor})
+	if err != nil {
+		return nil, err
+	}
 	return &api.GetKeysResponse{
-		Keys: db.GetKeys(p.db),
+		Keys:       keys,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) GetPrefixKeys(ctx context.Context, r *api.GetPrefixKeysRequest) (*api.GetPrefixKeysResponse, error) {
+	keys, next, err := db.GetPrefixKeys(p.db, r.Prefix, db.Page{Limit: r.Limit, Cursor: r.Cursor})
+	if err != nil {
+		return nil, err
+	}
 	return &api.GetPrefixKeysResponse{
-		Keys: db.GetPrefixKeys(p.db, r.Prefix),
+		Keys:       keys,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) GetRegexKeys(ctx context.Context, r *api.GetRegexKeysRequest) (*api.GetRegexKeysResponse, error) {
-	keys, err := db.GetRegexKeys(p.db, r.Regex)
+	keys, next, err := db.GetRegexKeys(p.db, r.Regex, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetRegexKeysResponse{
-		Keys: keys,
+		Keys:       keys,
+		NextCursor: next,
 	}, nil
 }
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) CreateMetadataIndex(ctx context.Context, r *api.CreateMetadataIndexRequest) (*api.CreateMetadataIndexResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := db.CreateMetadataIndex(p.db, r.Field); err != nil {
+		return nil, err
+	}
+	return &api.CreateMetadataIndexResponse{}, nil
+}
+
+func (p *GeoDB) DeleteMetadataIndex(ctx context.Context, r *api.DeleteMetadataIndexRequest) (*api.DeleteMetadataIndexResponse, error) {
+	if err := db.DeleteMetadataIndex(p.db, r.Fields); err != nil {
+		return nil, err
+	}
+	return &api.DeleteMetadataIndexResponse{}, nil
+}
+
+func (p *GeoDB) ListMetadataIndexes(ctx context.Context, r *api.ListMetadataIndexesRequest) (*api.ListMetadataIndexesResponse, error) {
+	fields, err := db.ListMetadataIndexes(p.db)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListMetadataIndexesResponse{
+		Fields: fields,
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
+	"time"
 )
 
 func (p *GeoDB) Set(ctx context.Context, r *api.SetRequest) (*api.SetResponse, error) {
@@ -21,38 +23,153 @@ func (p *GeoDB) Set(ctx context.Context, r *api.SetRequest) (*api.SetResponse, e
 	}, nil
 }
 
+func (p *GeoDB) SetBatch(ctx context.Context, r *api.SetBatchRequest) (*api.SetBatchResponse, error) {
+	if len(r.Objects) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one object is required")
+	}
+	return &api.SetBatchResponse{
+		Results: db.SetBatch(p.db, p.gmaps, p.hub, r.Objects),
+	}, nil
+}
+
+const (
+	// setStreamBatchSize is the number of streamed objects that are buffered before they are written as a batch
+	setStreamBatchSize = 500
+	// setStreamFlushInterval is the max time a streamed object is buffered before it is written
+	setStreamFlushInterval = 100 * time.Millisecond
+	// setStreamMaxErrors is the max number of failed results returned by SetStream
+	setStreamMaxErrors = 1000
+)
+
+func (p *GeoDB) SetStream(ss api.GeoDB_SetStreamServer) error {
+	type received struct {
+		request *api.SetRequest
+		err     error
+	}
+	var (
+		resp     = &api.SetStreamResponse{}
+		objects  []*api.Object
+		requests = make(chan received)
+		ticker   = time.NewTicker(setStreamFlushInterval)
+	)
+	defer ticker.Stop()
+	flush := func() {
+		if len(objects) == 0 {
+			return
+		}
+		for _, result := range db.SetBatch(p.db, p.gmaps, p.hub, objects) {
+			if result.Error == "" {
+				resp.Set++
+				continue
+			}
+			resp.Failed++
+			if len(resp.Results) < setStreamMaxErrors {
+				resp.Results = append(resp.Results, result)
+			}
+		}
+		objects = nil
+	}
+	// Recv blocks, so objects are received in the background to flush slow streams on the interval
+	go func() {
+		for {
+			r, err := ss.Recv()
+			select {
+			case requests <- received{request: r, err: err}:
+			case <-ss.Context().Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case msg := <-requests:
+			if msg.err == io.EOF {
+				flush()
+				return ss.SendAndClose(resp)
+			}
+			if msg.err != nil {
+				// the objects that were received before the stream broke are still written
+				flush()
+				return msg.err
+			}
+			objects = append(objects, msg.request.Object)
+			if len(objects) == setStreamBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
 func (p *GeoDB) GetRegex(ctx context.Context, r *api.GetRegexRequest) (*api.GetRegexResponse, error) {
-	objects, err := db.GetRegex(p.db, r.Regex)
+	objects, next, err := db.GetRegex(p.db, r.Regex, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetRegexResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) Get(ctx context.Context, r *api.GetRequest) (*api.GetResponse, error) {
-	objects, err := db.Get(p.db, r.Keys)
+	objects, next, err := db.Get(p.db, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) GetPrefix(ctx context.Context, r *api.GetPrefixRequest) (*api.GetPrefixResponse, error) {
-	objects, err := db.GetPrefix(p.db, r.Prefix)
+	objects, next, err := db.GetPrefix(p.db, r.Prefix, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetPrefixResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: next,
 	}, nil
 }
 
+func (p *GeoDB) GetStream(r *api.GetRequest, ss api.GeoDB_GetStreamServer) error {
+	_, err := db.GetFunc(p.db, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.GetStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) GetRegexStream(r *api.GetRegexRequest, ss api.GeoDB_GetRegexStreamServer) error {
+	_, err := db.GetRegexFunc(p.db, r.Regex, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.GetRegexStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) GetPrefixStream(r *api.GetPrefixRequest, ss api.GeoDB_GetPrefixStreamServer) error {
+	_, err := db.GetPrefixFunc(p.db, r.Prefix, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.GetPrefixStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
 func (p *GeoDB) Delete(ctx context.Context, r *api.DeleteRequest) (*api.DeleteResponse, error) {
-	if err := db.Delete(p.db, r.Keys); err != nil {
+	if err := db.Delete(p.db, p.hub, r.Keys); err != nil {
 		return nil, err
 	}
 	return &api.DeleteResponse{}, nil
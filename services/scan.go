@@ -4,34 +4,109 @@ import (
 	"context"
 	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (p *GeoDB) ScanBound(ctx context.Context, r *api.ScanBoundRequest) (*api.ScanBoundResponse, error) {
-	objects, err := db.ScanBound(p.db, r.Bound, r.Keys)
+	objects, next, err := db.ScanBound(p.db, r.Bound, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanBoundResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) ScanRegexBound(ctx context.Context, r *api.ScanRegexBoundRequest) (*api.ScanRegexBoundResponse, error) {
-	objects, err := db.ScanRegexBound(p.db, r.Bound, r.Regex)
+	objects, next, err := db.ScanRegexBound(p.db, r.Bound, r.Regex, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanRegexBoundResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: next,
 	}, nil
 }
 
 func (p *GeoDB) ScanPrefixBound(ctx context.Context, r *api.ScanPrefixBoundRequest) (*api.ScanPrefixBoundResponse, error) {
-	objects, err := db.ScanPrefixBound(p.db, r.Bound, r.Prefix)
+	objects, next, err := db.ScanPrefixBound(p.db, r.Bound, r.Prefix, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanPrefixBoundResponse{
+		Objects:    objects,
+		NextCursor: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanPolygon(ctx context.Context, r *api.ScanPolygonRequest) (*api.ScanPolygonResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.ScanPolygon(p.db, r.Polygon, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanPolygonResponse{
+		Objects:    objects,
+		NextCursor: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanBoundStream(r *api.ScanBoundRequest, ss api.GeoDB_ScanBoundStreamServer) error {
+	_, err := db.ScanBoundFunc(p.db, r.Bound, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.ScanBoundStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) ScanRegexBoundStream(r *api.ScanRegexBoundRequest, ss api.GeoDB_ScanRegexBoundStreamServer) error {
+	_, err := db.ScanRegexBoundFunc(p.db, r.Bound, r.Regex, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.ScanRegexBoundStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) ScanPrefixBoundStream(r *api.ScanPrefixBoundRequest, ss api.GeoDB_ScanPrefixBoundStreamServer) error {
+	_, err := db.ScanPrefixBoundFunc(p.db, r.Bound, r.Prefix, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.ScanPrefixBoundStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) ScanPolygonStream(r *api.ScanPolygonRequest, ss api.GeoDB_ScanPolygonStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	_, err := db.ScanPolygonFunc(p.db, r.Polygon, r.Keys, r.Filter, db.Page{Limit: r.Limit, Cursor: r.Cursor}, func(key string, obj *api.ObjectDetail, cursor string) error {
+		return ss.Send(&api.ScanPolygonStreamResponse{
+			Object: obj,
+			Cursor: cursor,
+		})
+	})
+	return err
+}
+
+func (p *GeoDB) Nearby(ctx context.Context, r *api.NearbyRequest) (*api.NearbyResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, err := db.Nearby(p.db, r.Point, int(r.K), r.MaxDistance, r.Prefix, r.Regex, r.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return &api.NearbyResponse{
 		Objects: objects,
 	}, nil
 }
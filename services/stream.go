@@ -1,94 +1,196 @@
 package services
 
 import (
+	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/stream"
 	log "github.com/sirupsen/logrus"
-	"github.com/thoas/go-funk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"regexp"
-	"strings"
 )
 
+// errStreamClosed is returned when the hub disconnects a client that can't keep up with the stream
+var errStreamClosed = status.Error(codes.ResourceExhausted, "stream closed: client is too slow to keep up with the stream")
+
 func (p *GeoDB) Stream(r *api.StreamRequest, ss api.GeoDB_StreamServer) error {
-	clientID := p.hub.AddObjectStreamClient(r.ClientId)
-	for {
-		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if len(r.Keys) > 0 {
-				if funk.ContainsString(r.Keys, msg.Object.Key) {
-					if err := ss.Send(&api.StreamResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
+	return p.streamObjects(ss, r.ClientId, r.ResumeSequence, r.SinceUnix, &stream.ObjectFilter{
+		Keys:     r.Keys,
+		Metadata: r.Metadata,
+	}, func(event *api.ObjectEvent) error {
+		return ss.Send(&api.StreamResponse{
+			Object:        event.Object,
+			Type:          event.Type,
+			TimestampUnix: event.TimestampUnix,
+			Sequence:      event.Sequence,
+		})
+	})
+}
+
+func (p *GeoDB) StreamRegex(r *api.StreamRegexRequest, ss api.GeoDB_StreamRegexServer) error {
+	rgex, err := regexp.Compile(r.Regex)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return p.streamObjects(ss, r.ClientId, r.ResumeSequence, r.SinceUnix, &stream.ObjectFilter{
+		Regex:    rgex,
+		Metadata: r.Metadata,
+	}, func(event *api.ObjectEvent) error {
+		return ss.Send(&api.StreamRegexResponse{
+			Object:        event.Object,
+			Type:          event.Type,
+			TimestampUnix: event.TimestampUnix,
+			Sequence:      event.Sequence,
+		})
+	})
+}
+
+func (p *GeoDB) StreamPrefix(r *api.StreamPrefixRequest, ss api.GeoDB_StreamPrefixServer) error {
+	return p.streamObjects(ss, r.ClientId, r.ResumeSequence, r.SinceUnix, &stream.ObjectFilter{
+		Prefix:   r.Prefix,
+		Metadata: r.Metadata,
+	}, func(event *api.ObjectEvent) error {
+		return ss.Send(&api.StreamPrefixResponse{
+			Object:        event.Object,
+			Type:          event.Type,
+			TimestampUnix: event.TimestampUnix,
+			Sequence:      event.Sequence,
+		})
+	})
+}
+
+func (p *GeoDB) StreamBound(r *api.StreamBoundRequest, ss api.GeoDB_StreamBoundServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if r.Bound.Center == nil {
+		return status.Error(codes.InvalidArgument, "a bound must have a center")
+	}
+	return p.streamObjects(ss, r.ClientId, r.ResumeSequence, r.SinceUnix, &stream.ObjectFilter{
+		Keys:     r.Keys,
+		Bound:    r.Bound,
+		Metadata: r.Metadata,
+	}, func(event *api.ObjectEvent) error {
+		return ss.Send(&api.StreamBoundResponse{
+			Object:        event.Object,
+			Type:          event.Type,
+			TimestampUnix: event.TimestampUnix,
+			Sequence:      event.Sequence,
+		})
+	})
+}
+
+func (p *GeoDB) StreamPolygon(r *api.StreamPolygonRequest, ss api.GeoDB_StreamPolygonServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := helpers.ValidatePolygon(r.Polygon); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return p.streamObjects(ss, r.ClientId, r.ResumeSequence, r.SinceUnix, &stream.ObjectFilter{
+		Keys:     r.Keys,
+		Polygon:  r.Polygon,
+		Metadata: r.Metadata,
+	}, func(event *api.ObjectEvent) error {
+		return ss.Send(&api.StreamPolygonResponse{
+			Object:        event.Object,
+			Type:          event.Type,
+			TimestampUnix: event.TimestampUnix,
+			Sequence:      event.Sequence,
+		})
+	})
+}
+
+// replayCatchUp is the number of change log events a replay may return before a stream client is registered on the hub.
+// Larger replays are repeated first so that the events published during a replay can't overflow the clients queue.
+const replayCatchUp = 100
+
+// streamObjects registers a hub client with the filter & sends every matching object event until the stream is done.
+// If resume or since is set, the matching change log events are sent before the live events.
+func (p *GeoDB) streamObjects(ss grpc.ServerStream, clientID string, resume uint64, since int64, filter *stream.ObjectFilter, send func(event *api.ObjectEvent) error) error {
+	// the stream is established- http gateways commit their status
+	if err := ss.SendHeader(nil); err != nil {
+		return err
+	}
+	replayed := resume
+	replay := func() (int, error) {
+		count := 0
+		err := db.ReplayChangeLog(p.db, replayed, since, func(event *api.ObjectEvent) error {
+			replayed = event.Sequence
+			count++
+			matched, ok := filter.Event(event)
+			if !ok {
+				return nil
 			}
-		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
+			return send(matched)
+		})
+		return count, err
+	}
+	resuming := resume > 0 || since > 0
+	for resuming {
+		count, err := replay()
+		if err != nil {
+			return err
+		}
+		if count <= replayCatchUp {
 			break
 		}
+		if ss.Context().Err() != nil {
+			return nil
+		}
+	}
+	clientID = p.hub.AddObjectStreamClient(clientID, filter)
+	objects := p.hub.GetClientObjectStream(clientID)
+	defer p.hub.RemoveObjectStreamClient(clientID, objects)
+	// the events committed since the last replay may have been published before the client was registered, so the change log is replayed once more-
+	// live events that were replayed are skipped
+	if resuming {
+		if _, err := replay(); err != nil {
+			return err
+		}
 	}
-}
-
-func (p *GeoDB) StreamRegex(r *api.StreamRegexRequest, ss api.GeoDB_StreamRegexServer) error {
-	clientID := p.hub.AddObjectStreamClient(r.ClientId)
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if r.Regex != "" {
-				match, err := regexp.MatchString(r.Regex, msg.Object.Key)
-				if err != nil {
-					return err
-				}
-				if match {
-					if err := ss.Send(&api.StreamRegexResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamRegexResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
+		case msg, ok := <-objects:
+			if !ok {
+				return errStreamClosed
+			}
+			if msg.Sequence != 0 && msg.Sequence <= replayed {
+				continue
+			}
+			if err := send(msg); err != nil {
+				log.Error(err.Error())
 			}
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
 
-func (p *GeoDB) StreamPrefix(r *api.StreamPrefixRequest, ss api.GeoDB_StreamPrefixServer) error {
-	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+func (p *GeoDB) StreamGeofence(r *api.StreamGeofenceRequest, ss api.GeoDB_StreamGeofenceServer) error {
+	clientID := p.hub.AddGeofenceStreamClient(r.ClientId, &stream.GeofenceFilter{
+		Names: r.Names,
+	})
+	events := p.hub.GetClientGeofenceStream(clientID)
+	defer p.hub.RemoveGeofenceStreamClient(clientID, events)
+	if err := ss.SendHeader(nil); err != nil {
+		return err
+	}
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if r.Prefix != "" {
-				if strings.HasPrefix(msg.Object.Key, r.Prefix) {
-					if err := ss.Send(&api.StreamPrefixResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamPrefixResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
+		case event, ok := <-events:
+			if !ok {
+				return errStreamClosed
+			}
+			if err := ss.Send(&api.StreamGeofenceResponse{
+				Event: event,
+			}); err != nil {
+				log.Error(err.Error())
 			}
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) ImportTrace(r *api.ImportTraceRequest, ss api.GeoDB_ImportTraceServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	points, err := helpers.ParseTrace(r.Format, []byte(r.Trace))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	obj := &api.Object{
+		Key:      r.Key,
+		Radius:   r.Radius,
+		Tracking: r.Tracking,
+		Metadata: r.Metadata,
+	}
+	return db.ReplayTrace(ss.Context(), p.db, p.gmaps, p.hub, obj, points, r.Speed, func(detail *api.ObjectDetail, point helpers.TracePoint) error {
+		resp := &api.ImportTraceResponse{
+			Object: detail,
+		}
+		if !point.Time.IsZero() {
+			resp.RecordedUnix = point.Time.Unix()
+		}
+		return ss.Send(resp)
+	})
+}
@@ -0,0 +1,104 @@
+package stream
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/thoas/go-funk"
+	"regexp"
+	"strings"
+)
+
+// ObjectFilter selects the objects that are delivered to a stream client. It is matched once per object at publish time.
+// Empty fields match every object.
+type ObjectFilter struct {
+	Keys     []string
+	Prefix   string
+	Regex    *regexp.Regexp
+	Bound    *api.Bound
+	Polygon  *api.Polygon
+	Metadata map[string]string
+}
+
+// Match returns true if the object satisfies every field of the filter. A nil filter matches every object.
+func (f *ObjectFilter) Match(obj *api.ObjectDetail) bool {
+	if f == nil {
+		return true
+	}
+	if obj.GetObject() == nil {
+		return false
+	}
+	if len(f.Keys) > 0 && !funk.ContainsString(f.Keys, obj.Object.Key) {
+		return false
+	}
+	if f.Prefix != "" && !strings.HasPrefix(obj.Object.Key, f.Prefix) {
+		return false
+	}
+	if f.Regex != nil && !f.Regex.MatchString(obj.Object.Key) {
+		return false
+	}
+	for k, v := range f.Metadata {
+		if val, ok := obj.Object.Metadata[k]; !ok || val != v {
+			return false
+		}
+	}
+	return f.contains(obj.Object)
+}
+
+// Event matches the event against the filter. If a Set event moves an object that matched the filter out of its bound or polygon,
+// a copy of the event with the Exit type is returned so that the client learns the object is no longer inside.
+func (f *ObjectFilter) Event(event *api.ObjectEvent) (*api.ObjectEvent, bool) {
+	if f.Match(event.Object) {
+		return event, true
+	}
+	if f.Bound == nil && f.Polygon == nil {
+		return nil, false
+	}
+	if event.Type != api.EventType_Set || event.Previous == nil || !f.contains(event.Previous) {
+		return nil, false
+	}
+	// the object must still match every other field of the filter- only leaving the bound or polygon is an exit
+	spatial := &ObjectFilter{
+		Keys:     f.Keys,
+		Prefix:   f.Prefix,
+		Regex:    f.Regex,
+		Metadata: f.Metadata,
+	}
+	if !spatial.Match(event.Object) {
+		return nil, false
+	}
+	return &api.ObjectEvent{
+		Type:          api.EventType_Exit,
+		Object:        event.Object,
+		TimestampUnix: event.TimestampUnix,
+		Sequence:      event.Sequence,
+		Previous:      event.Previous,
+	}, true
+}
+
+// contains returns true if the object is inside the filters bound & polygon
+func (f *ObjectFilter) contains(obj *api.Object) bool {
+	if f.Bound != nil && (obj.Point == nil || !helpers.BoundContains(f.Bound, obj)) {
+		return false
+	}
+	if f.Polygon != nil && (obj.Point == nil || !helpers.PolygonContains(f.Polygon, obj.Point)) {
+		return false
+	}
+	return true
+}
+
+// GeofenceFilter selects the geofence events that are delivered to a stream client.
+// Empty fields match every event.
+type GeofenceFilter struct {
+	Names []string
+}
+
+// Match returns true if the event satisfies the filter. A nil filter matches every event.
+func (f *GeofenceFilter) Match(event *api.GeofenceEvent) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Names) > 0 && !funk.ContainsString(f.Names, event.Geofence) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package stream
+
+import (
+	"fmt"
+	"github.com/autom8ter/geodb/metrics"
+	"strings"
+	"sync"
+)
+
+// OverflowPolicy determines what happens when a stream clients queue is full
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest queued message to make room for the new one
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the new message
+	DropNewest
+	// Disconnect closes the stream of the slow client
+	Disconnect
+	// Conflate replaces a queued message with the same key with the new one so that only the latest message per key is delivered.
+	// If there is no queued message with the same key, the oldest message is discarded.
+	Conflate
+)
+
+// String returns the name of the policy as accepted by ParseOverflowPolicy
+func (p OverflowPolicy) String() string {
+	switch p {
+	case DropNewest:
+		return "drop_newest"
+	case Disconnect:
+		return "disconnect"
+	case Conflate:
+		return "conflate"
+	default:
+		return "drop_oldest"
+	}
+}
+
+// ParseOverflowPolicy parses one of drop_oldest(default), drop_newest, disconnect or conflate
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "drop_oldest", "":
+		return DropOldest, nil
+	case "drop_newest":
+		return DropNewest, nil
+	case "disconnect":
+		return Disconnect, nil
+	case "conflate":
+		return Conflate, nil
+	default:
+		return DropOldest, fmt.Errorf("unknown stream overflow policy: %s", policy)
+	}
+}
+
+// queue is a bounded, per-client message queue. Pushing to a queue never blocks so that a slow client can't block other clients.
+type queue struct {
+	mu     *sync.Mutex
+	items  []interface{}
+	key    func(item interface{}) string
+	size   int
+	policy OverflowPolicy
+	notify chan struct{}
+	done   chan struct{}
+	once   *sync.Once
+}
+
+func newQueue(size int, policy OverflowPolicy, key func(item interface{}) string) *queue {
+	if size <= 0 {
+		size = 1
+	}
+	return &queue{
+		mu:     &sync.Mutex{},
+		key:    key,
+		size:   size,
+		policy: policy,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		once:   &sync.Once{},
+	}
+}
+
+// push adds the item to the queue according to the overflow policy. It returns false if the client should be disconnected.
+func (q *queue) push(item interface{}) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	select {
+	case <-q.done:
+		return true
+	default:
+	}
+	if q.policy == Conflate {
+		k := q.key(item)
+		for i, queued := range q.items {
+			if q.key(queued) == k {
+				q.items[i] = item
+				return true
+			}
+		}
+	}
+	if len(q.items) >= q.size {
+		switch q.policy {
+		case DropNewest:
+			metrics.IncStreamDropped(q.policy.String())
+			return true
+		case Disconnect:
+			return false
+		default:
+			q.items[0] = nil
+			q.items = q.items[1:]
+			metrics.IncStreamDropped(q.policy.String())
+			metrics.AddStreamQueueDepth(q.policy.String(), -1)
+		}
+	}
+	q.items = append(q.items, item)
+	metrics.AddStreamQueueDepth(q.policy.String(), 1)
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (q *queue) pop() (interface{}, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) == 0 {
+		return nil, false
+	}
+	item := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+	metrics.AddStreamQueueDepth(q.policy.String(), -1)
+	return item, true
+}
+
+// pump delivers queued items to the client with send until the queue is closed
+func (q *queue) pump(send func(item interface{}) bool, closeOut func()) {
+	defer closeOut()
+	for {
+		select {
+		case <-q.notify:
+			for {
+				item, ok := q.pop()
+				if !ok {
+					break
+				}
+				if !send(item) {
+					return
+				}
+			}
+		case <-q.done:
+			return
+		}
+	}
+}
+
+func (q *queue) close() {
+	q.once.Do(func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		close(q.done)
+		// the messages that were never delivered are no longer queued
+		metrics.AddStreamQueueDepth(q.policy.String(), -len(q.items))
+		q.items = nil
+	})
+}
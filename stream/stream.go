@@ -3,79 +3,220 @@ package stream
 import (
 	"context"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
 	"github.com/gofrs/uuid"
 	"sync"
+	"time"
 )
 
-var objectChan = make(chan *api.ObjectDetail, 5000)
+type objectClient struct {
+	*queue
+	filter *ObjectFilter
+	out    chan *api.ObjectEvent
+}
+
+type geofenceClient struct {
+	*queue
+	filter *GeofenceFilter
+	out    chan *api.GeofenceEvent
+}
 
 type Hub struct {
-	objectClients map[string]chan *api.ObjectDetail
-	objMu         *sync.Mutex
+	objects         chan *api.ObjectEvent
+	geofences       chan *api.GeofenceEvent
+	objectClients   map[string]*objectClient
+	objMu           *sync.Mutex
+	geofenceClients map[string]*geofenceClient
+	geofenceMu      *sync.Mutex
+	bufferSize      int
+	policy          OverflowPolicy
 }
 
-func NewHub() *Hub {
+// NewHub creates a hub that buffers up to bufferSize messages per client & applies the overflow policy once a clients buffer is full
+func NewHub(bufferSize int, policy OverflowPolicy) *Hub {
 	return &Hub{
-		objectClients: map[string]chan *api.ObjectDetail{},
-		objMu:         &sync.Mutex{},
+		objects:         make(chan *api.ObjectEvent, 5000),
+		geofences:       make(chan *api.GeofenceEvent, 5000),
+		objectClients:   map[string]*objectClient{},
+		objMu:           &sync.Mutex{},
+		geofenceClients: map[string]*geofenceClient{},
+		geofenceMu:      &sync.Mutex{},
+		bufferSize:      bufferSize,
+		policy:          policy,
 	}
 }
 
 func (h *Hub) StartObjectStream(ctx context.Context) error {
 	for {
 		select {
-		case obj := <-objectChan:
-			if h.objectClients == nil {
-				h.objectClients = map[string]chan *api.ObjectDetail{}
+		case event := <-h.objects:
+			h.objMu.Lock()
+			for id, client := range h.objectClients {
+				matched, ok := client.filter.Event(event)
+				if !ok {
+					continue
+				}
+				if !client.push(matched) {
+					disconnect(client.queue)
+					delete(h.objectClients, id)
+				}
 			}
+			h.objMu.Unlock()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
 
-			for _, channel := range h.objectClients {
-				if channel != nil {
-					channel <- obj
+func (h *Hub) StartGeofenceStream(ctx context.Context) error {
+	for {
+		select {
+		case event := <-h.geofences:
+			h.geofenceMu.Lock()
+			for id, client := range h.geofenceClients {
+				if !client.filter.Match(event) {
+					continue
+				}
+				if !client.push(event) {
+					disconnect(client.queue)
+					delete(h.geofenceClients, id)
 				}
 			}
+			h.geofenceMu.Unlock()
 		case <-ctx.Done():
-			break
+			return nil
 		}
 	}
 }
 
-func (h *Hub) AddObjectStreamClient(clientID string) string {
+// disconnect closes the queue of a client that could not keep up with the stream
+func disconnect(q *queue) {
+	q.close()
+	metrics.IncStreamDisconnected()
+}
+
+// AddObjectStreamClient registers a stream client that receives the objects that match the filter(nil matches every object)
+func (h *Hub) AddObjectStreamClient(clientID string, filter *ObjectFilter) string {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
-	if h.objectClients == nil {
-		h.objectClients = map[string]chan *api.ObjectDetail{}
-	}
 	if clientID == "" {
 		id, _ := uuid.NewV4()
 		clientID = id.String()
 	}
-	h.objectClients[clientID] = make(chan *api.ObjectDetail)
+	if existing, ok := h.objectClients[clientID]; ok {
+		existing.close()
+	}
+	client := &objectClient{
+		queue: newQueue(h.bufferSize, h.policy, func(item interface{}) string {
+			return item.(*api.ObjectEvent).GetObject().GetObject().GetKey()
+		}),
+		filter: filter,
+		out:    make(chan *api.ObjectEvent),
+	}
+	go client.pump(func(item interface{}) bool {
+		select {
+		case client.out <- item.(*api.ObjectEvent):
+			return true
+		case <-client.done:
+			return false
+		}
+	}, func() {
+		close(client.out)
+	})
+	h.objectClients[clientID] = client
 	return clientID
 }
 
-func (h *Hub) RemoveObjectStreamClient(id string) {
+// RemoveObjectStreamClient removes the client with the id if its event channel is stream(see GetClientObjectStream).
+// A client that reconnected with the same id replaces the previous client, so the stream identifies the registration to remove.
+func (h *Hub) RemoveObjectStreamClient(id string, stream chan *api.ObjectEvent) {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
-	if _, ok := h.objectClients[id]; ok {
-		close(h.objectClients[id])
+	if client, ok := h.objectClients[id]; ok && client.out == stream {
+		client.close()
 		delete(h.objectClients, id)
 	}
 }
 
-func (h *Hub) GetClientObjectStream(id string) chan *api.ObjectDetail {
+// GetClientObjectStream returns the clients object event channel. The channel is closed when the client is removed or disconnected for being too slow.
+func (h *Hub) GetClientObjectStream(id string) chan *api.ObjectEvent {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
-	if _, ok := h.objectClients[id]; ok {
-		return h.objectClients[id]
+	if client, ok := h.objectClients[id]; ok {
+		return client.out
 	}
 	return nil
 }
 
-func PublishObject(obj *api.ObjectDetail) {
-	objectChan <- obj
+// AddGeofenceStreamClient registers a stream client that receives the geofence events that match the filter(nil matches every event)
+func (h *Hub) AddGeofenceStreamClient(clientID string, filter *GeofenceFilter) string {
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	if clientID == "" {
+		id, _ := uuid.NewV4()
+		clientID = id.String()
+	}
+	if existing, ok := h.geofenceClients[clientID]; ok {
+		existing.close()
+	}
+	client := &geofenceClient{
+		queue: newQueue(h.bufferSize, h.policy, func(item interface{}) string {
+			event := item.(*api.GeofenceEvent)
+			return event.Geofence + "/" + event.GetObject().GetKey()
+		}),
+		filter: filter,
+		out:    make(chan *api.GeofenceEvent),
+	}
+	go client.pump(func(item interface{}) bool {
+		select {
+		case client.out <- item.(*api.GeofenceEvent):
+			return true
+		case <-client.done:
+			return false
+		}
+	}, func() {
+		close(client.out)
+	})
+	h.geofenceClients[clientID] = client
+	return clientID
 }
 
+// RemoveGeofenceStreamClient removes the client with the id if its event channel is stream(see GetClientGeofenceStream).
+// A client that reconnected with the same id replaces the previous client, so the stream identifies the registration to remove.
+func (h *Hub) RemoveGeofenceStreamClient(id string, stream chan *api.GeofenceEvent) {
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	if client, ok := h.geofenceClients[id]; ok && client.out == stream {
+		client.close()
+		delete(h.geofenceClients, id)
+	}
+}
+
+// GetClientGeofenceStream returns the clients geofence event channel. The channel is closed when the client is removed or disconnected for being too slow.
+func (h *Hub) GetClientGeofenceStream(id string) chan *api.GeofenceEvent {
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	if client, ok := h.geofenceClients[id]; ok {
+		return client.out
+	}
+	return nil
+}
+
+// PublishObject publishes a Set event of the object
 func (h *Hub) PublishObject(obj *api.ObjectDetail) {
-	PublishObject(obj)
+	h.PublishObjectEvent(&api.ObjectEvent{
+		Type:          api.EventType_Set,
+		Object:        obj,
+		TimestampUnix: time.Now().Unix(),
+	})
+}
+
+// PublishObjectEvent publishes the event to the hubs object stream clients(see StartObjectStream)
+func (h *Hub) PublishObjectEvent(event *api.ObjectEvent) {
+	h.objects <- event
+}
+
+// PublishGeofenceEvent publishes the event to the hubs geofence stream clients(see StartGeofenceStream)
+func (h *Hub) PublishGeofenceEvent(event *api.GeofenceEvent) {
+	h.geofences <- event
 }